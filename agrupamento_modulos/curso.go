@@ -0,0 +1,112 @@
+package agrupamento_modulos
+
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
+
+// Licao é um exemplo executável do curso.
+// Executar escreve toda a saída da lição no writer recebido.
+type Licao struct {
+	Nome     string
+	Executar func(w io.Writer)
+}
+
+// Topico reúne as lições de um assunto, na ordem em que são apresentadas.
+// ID é o identificador curto usado em caminhos e comandos (ex.: "slice")
+// e Titulo é o texto exibido no cabeçalho (ex.: "SLICE").
+// Doc é o arquivo em docs/ que explica o tópico e Pacote é o diretório
+// com o código das lições; os dois podem ficar vazios.
+type Topico struct {
+	ID     string
+	Titulo string
+	Doc    string
+	Pacote string
+	Licoes []Licao
+}
+
+// Grupo é uma seção do curso (ex.: "Estruturas de Dados") com os seus tópicos.
+type Grupo struct {
+	Nome    string
+	Topicos []Topico
+}
+
+var curso = comSaidaPadrao([]Grupo{
+	grupoModulos,
+	grupoTiposBasicos,
+	grupoOperadores,
+	grupoControleFluxo,
+	grupoEstruturasDados,
+	grupoFuncoesAvancadas,
+	grupoMetodosEJSON,
+	grupoInterfaces,
+})
+
+// Curso devolve todos os grupos na ordem em que o curso é apresentado. O
+// Executar de cada lição aceita um writer nil, que vira os.Stdout.
+func Curso() []Grupo {
+	return curso
+}
+
+// comSaidaPadrao copia os grupos trocando o Executar de cada lição por um
+// que passa o writer por saida. As funções das lições nos outros pacotes
+// não aceitam nil; quem chega a elas pelo Curso não precisa se preocupar.
+func comSaidaPadrao(grupos []Grupo) []Grupo {
+	copia := make([]Grupo, len(grupos))
+	for i, grupo := range grupos {
+		copia[i] = Grupo{Nome: grupo.Nome, Topicos: make([]Topico, len(grupo.Topicos))}
+		for j, topico := range grupo.Topicos {
+			topico.Licoes = make([]Licao, len(topico.Licoes))
+			for k, licao := range grupo.Topicos[j].Licoes {
+				executar := licao.Executar
+				licao.Executar = func(w io.Writer) { executar(saida(w)) }
+				topico.Licoes[k] = licao
+			}
+			copia[i].Topicos[j] = topico
+		}
+	}
+	return copia
+}
+
+// BuscarTopico procura um tópico do curso pelo seu ID.
+func BuscarTopico(id string) (Topico, bool) {
+	for _, grupo := range Curso() {
+		for _, topico := range grupo.Topicos {
+			if topico.ID == id {
+				return topico, true
+			}
+		}
+	}
+	return Topico{}, false
+}
+
+// BuscarLicao procura uma lição pelo ID do tópico e pelo nome da lição.
+func BuscarLicao(topicoID, nome string) (Licao, bool) {
+	topico, ok := BuscarTopico(topicoID)
+	if !ok {
+		return Licao{}, false
+	}
+	for _, licao := range topico.Licoes {
+		if licao.Nome == nome {
+			return licao, true
+		}
+	}
+	return Licao{}, false
+}
+
+func executarGrupo(w io.Writer, grupo Grupo) {
+	for _, topico := range grupo.Topicos {
+		executarTopico(w, topico)
+	}
+}
+
+func executarTopico(w io.Writer, topico Topico) {
+	fmt.Fprintln(w, "--------------------------------")
+	fmt.Fprintln(w, idioma.T(topico.Titulo))
+	fmt.Fprintln(w, "--------------------------------")
+	for _, licao := range topico.Licoes {
+		licao.Executar(w)
+	}
+	fmt.Fprint(w, "\n")
+}
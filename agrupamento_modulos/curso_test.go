@@ -0,0 +1,150 @@
+package agrupamento_modulos
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"modulo/idioma"
+	"modulo/relogio"
+)
+
+// Para regravar os arquivos .golden depois de uma mudança intencional:
+//
+//	go test ./agrupamento_modulos -update
+var atualizar = flag.Bool("update", false, "regrava os arquivos .golden com a saída atual das lições")
+
+// Endereços de memória mudam a cada execução (ex.: lição de ponteiros). Eles
+// têm muitos algarismos, o que os separa de valores curtos como 0x0C.
+var enderecoMemoria = regexp.MustCompile(`0x[0-9a-f]{8,}`)
+
+// As lições que esperam (ex.: loops.LoopFor) rodam sem esperar nos testes.
+func TestMain(m *testing.M) {
+	relogio.Definir(relogio.Instantaneo{})
+	os.Exit(m.Run())
+}
+
+func normalizar(saida []byte) []byte {
+	return enderecoMemoria.ReplaceAll(saida, []byte("0xENDERECO"))
+}
+
+func TestLicoesGolden(t *testing.T) {
+	for _, grupo := range Curso() {
+		for _, topico := range grupo.Topicos {
+			for _, licao := range topico.Licoes {
+				t.Run(topico.ID+"/"+licao.Nome, func(t *testing.T) {
+					var buf bytes.Buffer
+					licao.Executar(&buf)
+					obtido := normalizar(buf.Bytes())
+
+					caminho := filepath.Join("testdata", "golden", topico.ID, licao.Nome+".golden")
+					if *atualizar {
+						if err := os.MkdirAll(filepath.Dir(caminho), 0o755); err != nil {
+							t.Fatal(err)
+						}
+						if err := os.WriteFile(caminho, obtido, 0o644); err != nil {
+							t.Fatal(err)
+						}
+						return
+					}
+
+					esperado, err := os.ReadFile(caminho)
+					if err != nil {
+						t.Fatalf("arquivo golden não encontrado (rode com -update para criar): %v", err)
+					}
+					if !bytes.Equal(obtido, esperado) {
+						t.Errorf("a saída mudou em relação a %s\n--- esperado\n%s\n--- obtido\n%s", caminho, esperado, obtido)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestCursoSemIDsRepetidos(t *testing.T) {
+	topicos := map[string]bool{}
+	for _, grupo := range Curso() {
+		for _, topico := range grupo.Topicos {
+			if topicos[topico.ID] {
+				t.Errorf("tópico repetido: %s", topico.ID)
+			}
+			topicos[topico.ID] = true
+
+			licoes := map[string]bool{}
+			for _, licao := range topico.Licoes {
+				if licoes[licao.Nome] {
+					t.Errorf("lição repetida em %s: %s", topico.ID, licao.Nome)
+				}
+				licoes[licao.Nome] = true
+			}
+		}
+	}
+}
+
+func TestCursoEmIngles(t *testing.T) {
+	idioma.Definir(idioma.Ingles)
+	defer idioma.Definir(idioma.Portugues)
+
+	esperados := map[string]string{
+		"variaveis":  "First name: Mike, Last name: marciano",
+		"operadores": "STEP 2: BLOCK 1 is false, so BLOCK 2 is NOT evaluated (SHORT-CIRCUIT)",
+		"ifelse":     "You are a minor",
+		"switchs":    "Invalid day",
+		"slice":      "Slice capacity: 5",
+		"ponteiro":   "Value of the pointer: 11",
+		"funcoes":    "Function with return: 1 + 2 = 3",
+	}
+	for id, esperado := range esperados {
+		topico, ok := BuscarTopico(id)
+		if !ok {
+			t.Fatalf("tópico %s não existe", id)
+		}
+		var buf bytes.Buffer
+		executarTopico(&buf, topico)
+		if !bytes.Contains(buf.Bytes(), []byte(esperado)) {
+			t.Errorf("%s: saída sem %q:\n%s", id, esperado, buf.String())
+		}
+	}
+}
+
+func TestLoopForUsaORelogio(t *testing.T) {
+	licao, ok := BuscarLicao("loops", "LoopFor")
+	if !ok {
+		t.Fatal("lição loops/LoopFor não encontrada")
+	}
+	falso := relogio.NovoFalso(time.Time{})
+	relogio.Definir(falso)
+	defer relogio.Definir(relogio.Instantaneo{})
+
+	licao.Executar(io.Discard)
+	if esperas := falso.Esperas(); len(esperas) != 10 || esperas[0] != time.Second {
+		t.Errorf("LoopFor deveria esperar 1s dez vezes, esperou %v", esperas)
+	}
+}
+
+func TestLicaoDoCursoComWriterNil(t *testing.T) {
+	licao, ok := BuscarLicao("slice", "Append")
+	if !ok {
+		t.Fatal(`BuscarLicao("slice", "Append") não encontrou a lição`)
+	}
+
+	arquivo, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer arquivo.Close()
+	stdout := os.Stdout
+	os.Stdout = arquivo
+	defer func() { os.Stdout = stdout }()
+
+	licao.Executar(nil)
+
+	if info, err := arquivo.Stat(); err != nil || info.Size() == 0 {
+		t.Errorf("Executar(nil) não escreveu em os.Stdout (%v)", err)
+	}
+}
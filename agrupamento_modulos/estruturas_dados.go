@@ -2,6 +2,7 @@ package agrupamento_modulos
 
 import (
 	"fmt"
+	"io"
 	"modulo/array"
 	"modulo/heranca"
 	"modulo/maps"
@@ -10,69 +11,97 @@ import (
 	"modulo/structs"
 )
 
-func ExecutarEstruturasDados() {
-	fmt.Println("--------------------------------")
-	fmt.Println("STRUCTS")
-	fmt.Println("--------------------------------")
-	structs.Structs()
-	fmt.Print("\n")
+var grupoEstruturasDados = Grupo{
+	Nome: "Estruturas de Dados",
+	Topicos: []Topico{
+		{
+			ID:     "structs",
+			Titulo: "STRUCTS",
+			Doc:    "README_STRUCTS.md",
+			Pacote: "structs",
+			Licoes: []Licao{
+				{"Structs", structs.Structs},
+			},
+		},
+		{
+			ID:     "heranca",
+			Titulo: "HERANÇA",
+			Doc:    "README_HERANCA.md",
+			Pacote: "heranca",
+			Licoes: []Licao{
+				{"Cachorro", cachorro},
+			},
+		},
+		{
+			ID:     "array",
+			Titulo: "ARRAY",
+			Doc:    "README_ARRAY.md",
+			Pacote: "array",
+			Licoes: []Licao{
+				{"DeclaracaoEAtribuicaoSeparada", array.DeclaracaoEAtribuicaoSeparada},
+				{"DeclaracaoEInicializacaoNaMesmaLinha", array.DeclaracaoEInicializacaoNaMesmaLinha},
+				{"InicializacaoComTamanhoInferido", array.InicializacaoComTamanhoInferido},
+				{"InicializacaoComIndicesEspecificos", array.InicializacaoComIndicesEspecificos},
+			},
+		},
+		{
+			ID:     "slice",
+			Titulo: "SLICE",
+			Doc:    "README_SLICE.md",
+			Pacote: "slice",
+			Licoes: []Licao{
+				{"Slice", slice.Slice},
+				{"Append", slice.Append},
+				{"AppendMultiplos", slice.AppendMultiplos},
+				{"RemoverItemPorIndice", slice.RemoverItemPorIndice},
+				{"AtribuiArrayASlice", slice.AtribuiArrayASlice},
+				{"AtribuiArrayASlicePeloIndice", slice.AtribuiArrayASlicePeloIndice},
+				{"CriarSliceComMake", slice.CriarSliceComMake},
+				{"CriarSliceComMakeVazioComCapacidadeInicial", slice.CriarSliceComMakeVazioComCapacidadeInicial},
+				{"CriarSliceComMakePrePreenchidoComZeros", slice.CriarSliceComMakePrePreenchidoComZeros},
+				{"CriarSliceComMakeTamanhoECapacidadeDiferentes", slice.CriarSliceComMakeTamanhoECapacidadeDiferentes},
+			},
+		},
+		{
+			ID:     "ponteiro",
+			Titulo: "PONTEIRO",
+			Doc:    "README_PONTEIRO.md",
+			Pacote: "ponteiro",
+			Licoes: []Licao{
+				{"AtribuiValorParaVariavel", ponteiro.AtribuiValorParaVariavel},
+				{"DiferencaEntrePonteiroEValor", ponteiro.DiferencaEntrePonteiroEValor},
+				{"ModificarValorDaVariavelApontadaPorPonteiro", ponteiro.ModificarValorDaVariavelApontadaPorPonteiro},
+			},
+		},
+		{
+			ID:     "maps",
+			Titulo: "MAPS",
+			Pacote: "maps",
+			Licoes: []Licao{
+				{"Maps", maps.Maps},
+				{"MapAninhado", maps.MapAninhado},
+				{"DeletarItemDoMap", maps.DeletarItemDoMap},
+				{"AdicionarItemNoMap", maps.AdicionarItemNoMap},
+				{"AdicionarMapEmOutroMap", maps.AdicionarMapEmOutroMap},
+			},
+		},
+	},
+}
+
+func ExecutarEstruturasDados(w io.Writer) {
+	executarGrupo(saida(w), grupoEstruturasDados)
+}
 
-	fmt.Println("--------------------------------")
-	fmt.Println("HERANÇA")
-	fmt.Println("--------------------------------")
+func cachorro(w io.Writer) {
 	cachorro := heranca.Cachorro{}
 	cachorro.Cor = "Preto"
 	cachorro.Nome = "Rex"
 	cachorro.Idade = 5
 	cachorro.Peso = 15.5
 	cachorro.Raça = "Labrador"
-	fmt.Println(cachorro.Cor)
-	fmt.Println(cachorro.Nome)
-	fmt.Println(cachorro.Idade)
-	fmt.Println(cachorro.Peso)
-	fmt.Println(cachorro.Raça)
-	fmt.Print("\n")
-
-	fmt.Println("--------------------------------")
-	fmt.Println("ARRAY")
-	fmt.Println("--------------------------------")
-	array.DeclaracaoEAtribuicaoSeparada()
-	array.DeclaracaoEInicializacaoNaMesmaLinha()
-	array.InicializacaoComTamanhoInferido()
-	array.InicializacaoComIndicesEspecificos()
-	fmt.Print("\n")
-
-	fmt.Println("--------------------------------")
-	fmt.Println("SLICE")
-	fmt.Println("--------------------------------")
-	slice.Slice()
-	slice.Append()
-	slice.AppendMultiplos()
-	slice.RemoverItemPorIndice()
-	slice.AtribuiArrayASlice()
-	slice.AtribuiArrayASlicePeloIndice()
-	slice.CriarSliceComMake()
-	slice.CriarSliceComMakeVazioComCapacidadeInicial()
-	slice.CriarSliceComMakePrePreenchidoComZeros()
-	slice.CriarSliceComMakeTamanhoECapacidadeDiferentes()
-	fmt.Print("\n")
-
-	fmt.Println("--------------------------------")
-	fmt.Println("PONTEIRO")
-	fmt.Println("--------------------------------")
-	ponteiro.AtribuiValorParaVariavel()
-	ponteiro.DiferencaEntrePonteiroEValor()
-	ponteiro.ModificarValorDaVariavelApontadaPorPonteiro()
-	fmt.Print("\n")
-
-	fmt.Println("--------------------------------")
-	fmt.Println("MAPS")
-	fmt.Println("--------------------------------")
-	maps.Maps()
-	maps.MapAninhado()
-	maps.DeletarItemDoMap()
-	maps.AdicionarItemNoMap()
-	maps.AdicionarMapEmOutroMap()
-	fmt.Print("\n")
+	fmt.Fprintln(w, cachorro.Cor)
+	fmt.Fprintln(w, cachorro.Nome)
+	fmt.Fprintln(w, cachorro.Idade)
+	fmt.Fprintln(w, cachorro.Peso)
+	fmt.Fprintln(w, cachorro.Raça)
 }
-
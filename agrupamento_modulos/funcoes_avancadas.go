@@ -2,45 +2,117 @@ package agrupamento_modulos
 
 import (
 	"fmt"
+	"io"
 	"modulo/funcoes"
+	"modulo/idioma"
 )
 
-func ExecutarFuncoesAvancadas() {
-	fmt.Println("--------------------------------")
-	fmt.Println("FUNÇÕES")
-	fmt.Println("--------------------------------")
-	fmt.Println("FUNÇÃO COM RETORNO:", funcoes.FuncaoComRetorno(1, 2))
-	fmt.Println("REUPERANDO VALOR DA FUNÇÃO COM VARIAVEL:", funcoes.RecuperandoValorDaFuncaoComVariavel(1, 2))
-	fmt.Print("FUNÇÃO SEM RETORNO: ")
-	funcoes.FuncaoSemRetorno()
-	fmt.Println("PASSANDO FUNÇÃO PARA VARIAVEL:", funcoes.PassandoFuncaoParaVariavel(1, 2))
-	soma, subtracao := funcoes.FuncaoComMaisDeUmRetorno(1, 2)
-	fmt.Println("FUNÇÃO COM MAIS DE UM RETORNO:", soma, subtracao)
-	soma, _ = funcoes.FuncaoComMaisDeUmRetorno(1, 2)
-	fmt.Println("FUNÇÃO COM MAIS DE UM RETORNO - IGNORANDO SEGUNDO RETORNO:", soma)
-	fmt.Print("\n")
-
-	fmt.Println("--------------------------------")
-	fmt.Println("FUNÇÕES AVANÇADAS")
-	fmt.Println("--------------------------------")
-	somaNomeado, subtracaoNomeado := funcoes.FuncaoRetornoNomeado(10, 5)
-	fmt.Println("FUNÇÃO COM RETORNO NOMEADO - Soma:", somaNomeado, "Subtração:", subtracaoNomeado)
-	funcoes.FuncaoVariaticaComMaisDeUmParametro(1, 2, 3, 4, 10)
-	funcoes.FuncaoVariaticaComMaisDeUmParametroComRetorno("Ola Mundo", 1, 2, 3, 4, 10)
-	soma, subtracao = funcoes.FuncaoComMaisDeUmRetorno(1, 2)
-	fmt.Println("FUNÇÃO COM MAIS DE UM RETORNO:", soma, subtracao)
-	fmt.Println("FUNÇÃO RECURSIVA:", funcoes.FuncaoRecursiva(15))
-	defer funcoes.Defer()
-	funcoes.SemDefer()
-	fmt.Println("Aluno aprovado:", funcoes.AlunoAprovado(7, 8))
-	funcoes.FuncaoPanic(5, 4)
-	texto := "Dentro da main"
-	fmt.Println(texto)
-	novaFuncao := funcoes.FuncaoClosure()
-	novaFuncao()
-	numero := 10
-	funcoes.FuncaoPonteiro(&numero)
-	fmt.Println("Numero:", numero)
-	fmt.Print("\n")
+var grupoFuncoesAvancadas = Grupo{
+	Nome: "Funções Avançadas",
+	Topicos: []Topico{
+		{
+			ID:     "funcoes",
+			Titulo: "FUNÇÕES",
+			Doc:    "README_FUNCOES.md",
+			Pacote: "funcoes",
+			Licoes: []Licao{
+				{"FuncaoComRetorno", func(w io.Writer) {
+					fmt.Fprintln(w, idioma.T("FUNÇÃO COM RETORNO:"), funcoes.FuncaoComRetorno(1, 2))
+				}},
+				{"RecuperandoValorDaFuncaoComVariavel", func(w io.Writer) {
+					fmt.Fprintln(w, idioma.T("REUPERANDO VALOR DA FUNÇÃO COM VARIAVEL:"), funcoes.RecuperandoValorDaFuncaoComVariavel(1, 2))
+				}},
+				{"FuncaoSemRetorno", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("FUNÇÃO SEM RETORNO: "))
+					funcoes.FuncaoSemRetorno(w)
+				}},
+				{"PassandoFuncaoParaVariavel", func(w io.Writer) {
+					fmt.Fprintln(w, idioma.T("PASSANDO FUNÇÃO PARA VARIAVEL:"), funcoes.PassandoFuncaoParaVariavel(w, 1, 2))
+				}},
+				{"FuncaoComMaisDeUmRetorno", func(w io.Writer) {
+					soma, subtracao := funcoes.FuncaoComMaisDeUmRetorno(1, 2)
+					fmt.Fprintln(w, idioma.T("FUNÇÃO COM MAIS DE UM RETORNO:"), soma, subtracao)
+					soma, _ = funcoes.FuncaoComMaisDeUmRetorno(1, 2)
+					fmt.Fprintln(w, idioma.T("FUNÇÃO COM MAIS DE UM RETORNO - IGNORANDO SEGUNDO RETORNO:"), soma)
+				}},
+			},
+		},
+		{
+			ID:     "funcoes_avancadas",
+			Titulo: "FUNÇÕES AVANÇADAS",
+			Doc:    "README_FUNCOES.md",
+			Pacote: "funcoes",
+			Licoes: []Licao{
+				{"FuncaoRetornoNomeado", func(w io.Writer) {
+					somaNomeado, subtracaoNomeado := funcoes.FuncaoRetornoNomeado(10, 5)
+					fmt.Fprintln(w, idioma.T("FUNÇÃO COM RETORNO NOMEADO - Soma:"), somaNomeado, idioma.T("Subtração:"), subtracaoNomeado)
+				}},
+				{"FuncaoVariaticaComMaisDeUmParametro", func(w io.Writer) {
+					funcoes.FuncaoVariaticaComMaisDeUmParametro(w, 1, 2, 3, 4, 10)
+				}},
+				{"FuncaoVariaticaComMaisDeUmParametroComRetorno", func(w io.Writer) {
+					funcoes.FuncaoVariaticaComMaisDeUmParametroComRetorno(w, "Ola Mundo", 1, 2, 3, 4, 10)
+				}},
+				{"FuncaoVariaticaGenerica", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("FUNÇÃO VARIÁTICA GENÉRICA: "))
+					funcoes.FuncaoVariaticaGenerica(w)
+				}},
+				{"FuncaoRecursiva", func(w io.Writer) {
+					fmt.Fprintln(w, idioma.T("FUNÇÃO RECURSIVA:"), funcoes.FuncaoRecursiva(15))
+				}},
+				{"FibonacciChamadas", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("FIBONACCI SEM REPETIR CONTAS: "))
+					funcoes.FibonacciChamadas(w)
+				}},
+				{"RecursaoRastreada", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("RECURSÃO RASTREADA COM DEFER: "))
+					funcoes.RecursaoRastreada(w)
+				}},
+				{"Defer", func(w io.Writer) {
+					// O defer só roda quando esta lição termina, depois das
+					// linhas que vêm abaixo dele.
+					defer funcoes.Defer(w)
+					funcoes.SemDefer(w)
+					fmt.Fprintln(w, idioma.T("Fim da lição: só agora o defer é executado"))
+				}},
+				{"AlunoAprovado", func(w io.Writer) {
+					fmt.Fprintln(w, idioma.T("Aluno aprovado:"), funcoes.AlunoAprovado(w, 7, 8))
+				}},
+				{"BoletimDaTurma", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("BOLETIM DA TURMA: "))
+					funcoes.BoletimDaTurma(w)
+				}},
+				{"FuncaoPanic", func(w io.Writer) {
+					funcoes.FuncaoPanic(w, 5, 4)
+				}},
+				{"FuncaoComErro", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("FUNÇÃO COM ERRO EM VEZ DE PANIC: "))
+					funcoes.FuncaoComErro(w)
+				}},
+				{"FuncaoClosure", func(w io.Writer) {
+					texto := idioma.T("Dentro da main")
+					fmt.Fprintln(w, texto)
+					novaFuncao := funcoes.FuncaoClosure(w)
+					novaFuncao()
+				}},
+				{"FuncaoClosureComEstado", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("CLOSURES COM ESTADO: "))
+					funcoes.FuncaoClosureComEstado(w)
+				}},
+				{"FuncaoDecorada", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("FUNÇÃO DECORADA: "))
+					funcoes.FuncaoDecorada(w)
+				}},
+				{"FuncaoPonteiro", func(w io.Writer) {
+					numero := 10
+					funcoes.FuncaoPonteiro(w, &numero)
+					fmt.Fprintln(w, idioma.T("Numero:"), numero)
+				}},
+			},
+		},
+	},
 }
 
+func ExecutarFuncoesAvancadas(w io.Writer) {
+	executarGrupo(saida(w), grupoFuncoesAvancadas)
+}
@@ -1,18 +1,52 @@
 package agrupamento_modulos
 
 import (
+	"fmt"
+	"io"
+	"modulo/idioma"
 	"modulo/interfaces"
 )
 
-func ExecutarInterface(){
-	r := interfaces.Retangulo{Altura: 10, Largura: 30}
-	interfaces.EscreverArea(r)
+var grupoInterfaces = Grupo{
+	Nome: "Interfaces",
+	Topicos: []Topico{
+		{
+			ID:     "interfaces",
+			Titulo: "INTERFACES",
+			Doc:    "README_INTERFACES.md",
+			Pacote: "interfaces",
+			Licoes: []Licao{
+				{"EscreverArea", func(w io.Writer) {
+					r := interfaces.Retangulo{Altura: 10, Largura: 30}
+					interfaces.EscreverArea(w, r)
 
-	c := interfaces.Circulo{Raio: 10}
-	interfaces.EscreverArea(c)
+					c := interfaces.Circulo{Raio: 10}
+					interfaces.EscreverArea(w, c)
+				}},
+				{"Formas", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("FORMAS: "))
+					interfaces.Formas(w)
+				}},
+			},
+		},
+		{
+			ID:     "interface_generica",
+			Titulo: "INTERFACE GENERICA",
+			Pacote: "interfaces",
+			Licoes: []Licao{
+				{"Generica", func(w io.Writer) {
+					interfaces.Generica(w, "Ola Mundo")
+					interfaces.Generica(w, 1.0)
+				}},
+			},
+		},
+	},
 }
 
-func ExecutarInerfaceGenerica(){
-	interfaces.Generica("Ola Mundo")
-	interfaces.Generica(1.0)
-}
\ No newline at end of file
+func ExecutarInterface(w io.Writer) {
+	executarTopico(saida(w), grupoInterfaces.Topicos[0])
+}
+
+func ExecutarInerfaceGenerica(w io.Writer) {
+	executarTopico(saida(w), grupoInterfaces.Topicos[1])
+}
@@ -3,30 +3,52 @@ package agrupamento_modulos
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"modulo/idioma"
 	jsons "modulo/json"
 	"modulo/metodos"
 )
 
-func ExecutarMetodosEJSON() {
-	fmt.Println("--------------------------------")
-	fmt.Println("METODOS")
-	fmt.Println("--------------------------------")
-	usuario := metodos.Usuario{Nome: "Mike", Email: "mike@example.com", Senha: "123456", Idade: 20}
-	usuario.Salvar()
-	usuario.AtualizarIdade()
-	fmt.Println("Idade: ", usuario.Idade)
-	fmt.Print("\n")
+var grupoMetodosEJSON = Grupo{
+	Nome: "Métodos e JSON",
+	Topicos: []Topico{
+		{
+			ID:     "metodos",
+			Titulo: "METODOS",
+			Doc:    "README_METODOS.md",
+			Pacote: "metodos",
+			Licoes: []Licao{
+				{"Usuario", func(w io.Writer) {
+					usuario := metodos.Usuario{Nome: "Mike", Email: "mike@example.com", Senha: "123456", Idade: 20}
+					usuario.Salvar(w)
+					usuario.AtualizarIdade()
+					fmt.Fprintln(w, idioma.T("Idade: "), usuario.Idade)
+				}},
+			},
+		},
+		{
+			ID:     "json",
+			Titulo: "JSONS",
+			Doc:    "README_JSON.md",
+			Pacote: "json",
+			Licoes: []Licao{
+				{"Marshal", jsonMarshal},
+			},
+		},
+	},
+}
+
+func ExecutarMetodosEJSON(w io.Writer) {
+	executarGrupo(saida(w), grupoMetodosEJSON)
+}
 
-	fmt.Println("--------------------------------")
-	fmt.Println("JSONS")
-	fmt.Println("--------------------------------")
+func jsonMarshal(w io.Writer) {
 	jsonExemplo := jsons.Usuario{Nome: "Mike", Idade: 20, Email: "mike@example.com"}
-	fmt.Println(jsonExemplo)
+	fmt.Fprintln(w, jsonExemplo)
 	jsonBytes, err := json.Marshal(jsonExemplo)
 	if err != nil {
-		fmt.Println("Erro ao fazer Marshal:", err)
+		fmt.Fprintln(w, idioma.T("Erro ao fazer Marshal:"), err)
 	} else {
-		fmt.Println("JSON:", string(jsonBytes))
+		fmt.Fprintln(w, "JSON:", string(jsonBytes))
 	}
 }
-
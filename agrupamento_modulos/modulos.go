@@ -2,22 +2,40 @@ package agrupamento_modulos
 
 import (
 	"fmt"
+	"io"
 	"modulo/modificador_acesso"
 
 	"github.com/badoux/checkmail"
 )
 
-func ExecutarModulos() {
-	fmt.Println("--------------------------------")
-	fmt.Println("MODULOS INTERNOS - MODIFICADOR DE ACESSO PUBLIC")
-	fmt.Println("--------------------------------")
-	modificador_acesso.FuncaoPublica()
-	fmt.Print("\n")
+var grupoModulos = Grupo{
+	Nome: "Módulos",
+	Topicos: []Topico{
+		{
+			ID:     "modificador_acesso",
+			Titulo: "MODULOS INTERNOS - MODIFICADOR DE ACESSO PUBLIC",
+			Doc:    "README_MODIFICADOR_DE_ACESSO.md",
+			Pacote: "modificador_acesso",
+			Licoes: []Licao{
+				{"FuncaoPublica", modificador_acesso.FuncaoPublica},
+			},
+		},
+		{
+			ID:     "checkmail",
+			Titulo: "MODULOS EXTERNOS - Checkmail",
+			Doc:    "README_MODULO.md",
+			Licoes: []Licao{
+				{"ValidateFormat", validarFormatoEmail},
+			},
+		},
+	},
+}
+
+func ExecutarModulos(w io.Writer) {
+	executarGrupo(saida(w), grupoModulos)
+}
 
-	fmt.Println("--------------------------------")
-	fmt.Println("MODULOS EXTERNOS - Checkmail")
-	fmt.Println("--------------------------------")
+func validarFormatoEmail(w io.Writer) {
 	erro := checkmail.ValidateFormat("teste@teste.com")
-	fmt.Println(erro)
-	fmt.Print("\n")
+	fmt.Fprintln(w, erro)
 }
@@ -2,52 +2,101 @@ package agrupamento_modulos
 
 import (
 	"fmt"
+	"io"
+	"modulo/idioma"
 	"modulo/ifelse"
 	"modulo/loops"
 	"modulo/operadores"
 	"modulo/switchs"
 )
 
-func ExecutarOperadores() {
-	fmt.Println("--------------------------------")
-	fmt.Println("OPERADORES")
-	fmt.Println("--------------------------------")
-	fmt.Print("OPERADORES ARITMETICOS: ")
-	operadores.OperadoresAritmeticos()
-	fmt.Print("OPERADORES RELACIONAIS: ")
-	operadores.OperadoresRelacionais()
-	fmt.Print("OPERADORES LOGICOS: ")
-	operadores.OperadoresLogicos()
-	fmt.Print("OPERADORES LOGICOS COM 3 COMBINACOES: ")
-	operadores.OperadoresLogicosTresCombinacoes()
-	fmt.Print("\n")
+var grupoOperadores = Grupo{
+	Nome: "Operadores",
+	Topicos: []Topico{
+		{
+			ID:     "operadores",
+			Titulo: "OPERADORES",
+			Doc:    "README_OPERADORES.md",
+			Pacote: "operadores",
+			Licoes: []Licao{
+				{"OperadoresAritmeticos", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("OPERADORES ARITMETICOS: "))
+					operadores.OperadoresAritmeticos(w)
+				}},
+				{"OperadoresRelacionais", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("OPERADORES RELACIONAIS: "))
+					operadores.OperadoresRelacionais(w)
+				}},
+				{"OperadoresBitABit", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("OPERADORES BIT A BIT: "))
+					operadores.OperadoresBitABit(w)
+				}},
+				{"OperadoresLogicos", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("OPERADORES LOGICOS: "))
+					operadores.OperadoresLogicos(w)
+				}},
+				{"OperadoresLogicosTresCombinacoes", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("OPERADORES LOGICOS COM 3 COMBINACOES: "))
+					operadores.OperadoresLogicosTresCombinacoes(w)
+				}},
+				{"OperadoresLogicosExpressao", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("OPERADORES LOGICOS EM QUALQUER EXPRESSAO: "))
+					operadores.OperadoresLogicosExpressao(w)
+				}},
+				{"OperadoresLogicosTabelaVerdade", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("TABELA VERDADE: "))
+					operadores.OperadoresLogicosTabelaVerdade(w)
+				}},
+			},
+		},
+	},
 }
 
-func ExecutarControleFluxo() {
-	fmt.Println("--------------------------------")
-	fmt.Println("IF ELSE")
-	fmt.Println("--------------------------------")
-	ifelse.IfElse()
-	ifelse.IfElseInicializandoVariavel()
-	fmt.Print("\n")
-
-	fmt.Println("--------------------------------")
-	fmt.Println("SWITCH")
-	fmt.Println("--------------------------------")
-	switchs.Switch()
-	fmt.Println(switchs.SwitchComRetorno())
-	fmt.Println(switchs.SwitcComAtribuicaoDeVariavel(1))
-	fmt.Print("\n")
+var grupoControleFluxo = Grupo{
+	Nome: "Controle de Fluxo",
+	Topicos: []Topico{
+		{
+			ID:     "ifelse",
+			Titulo: "IF ELSE",
+			Doc:    "README_ESTRUTURAS_CONTROLE.md",
+			Pacote: "ifelse",
+			Licoes: []Licao{
+				{"IfElse", ifelse.IfElse},
+				{"IfElseInicializandoVariavel", ifelse.IfElseInicializandoVariavel},
+			},
+		},
+		{
+			ID:     "switchs",
+			Titulo: "SWITCH",
+			Doc:    "README_SWITCH.md",
+			Pacote: "switchs",
+			Licoes: []Licao{
+				{"Switch", switchs.Switch},
+				{"SwitchComRetorno", func(w io.Writer) { fmt.Fprintln(w, switchs.SwitchComRetorno()) }},
+				{"SwitcComAtribuicaoDeVariavel", func(w io.Writer) { fmt.Fprintln(w, switchs.SwitcComAtribuicaoDeVariavel(1)) }},
+			},
+		},
+		{
+			ID:     "loops",
+			Titulo: "LOOPS",
+			Doc:    "README_LOOPS.md",
+			Pacote: "loops",
+			Licoes: []Licao{
+				{"LoopFor", loops.LoopFor},
+				{"LoopWhile", loops.LoopWhile},
+				{"LoopDoWhile", loops.LoopDoWhile},
+				{"LoopForRange", loops.LoopForRange},
+				{"LoopForRangeString", loops.LoopForRangeString},
+				{"LoopForRangeMap", loops.LoopForRangeMap},
+			},
+		},
+	},
+}
 
-	fmt.Println("--------------------------------")
-	fmt.Println("LOOPS")
-	fmt.Println("--------------------------------")
-	loops.LoopFor()
-	loops.LoopWhile()
-	loops.LoopDoWhile()
-	loops.LoopForRange()
-	loops.LoopForRangeString()
-	loops.LoopForRangeMap()
-	fmt.Print("\n")
+func ExecutarOperadores(w io.Writer) {
+	executarGrupo(saida(w), grupoOperadores)
 }
 
+func ExecutarControleFluxo(w io.Writer) {
+	executarGrupo(saida(w), grupoControleFluxo)
+}
@@ -0,0 +1,18 @@
+package agrupamento_modulos
+
+import (
+	"io"
+	"os"
+)
+
+// saida devolve o writer onde as lições vão escrever.
+// Quando nenhum writer é informado (nil), a saída padrão é o terminal (os.Stdout).
+// Vale para as funções Executar deste pacote e para o Executar das lições
+// devolvidas por Curso, BuscarTopico e BuscarLicao; as funções das lições nos
+// outros pacotes (ex.: slice.Append) precisam de um writer.
+func saida(w io.Writer) io.Writer {
+	if w == nil {
+		return os.Stdout
+	}
+	return w
+}
@@ -2,30 +2,57 @@ package agrupamento_modulos
 
 import (
 	"fmt"
+	"io"
+	"modulo/idioma"
 	"modulo/tiposdedados"
 	"modulo/variaveis"
 )
 
-func ExecutarTiposBasicos() {
-	fmt.Println("--------------------------------")
-	fmt.Println("VARIAVEIS")
-	fmt.Println("--------------------------------")
-	fmt.Println("VARIAVEL IMPLICITA:")
-	variaveis.VariavelImplicita()
-	fmt.Print("\n")
-	fmt.Println("VARIAVEL EXPLICITA:")
-	variaveis.VariavelExplicita()
-	fmt.Print("\n")
-
-	fmt.Println("--------------------------------")
-	fmt.Println("TIPOS DE DADOS")
-	fmt.Println("--------------------------------")
-	fmt.Println("INT:", tiposdedados.Int())
-	fmt.Println("UINT:", tiposdedados.Uint())
-	fmt.Println("FLOAT:", tiposdedados.Float())
-	fmt.Println("CHAR:", tiposdedados.Char())
-	fmt.Println("BOOL:", tiposdedados.Bool())
-	fmt.Println("ERRO:", tiposdedados.Erro())
-	fmt.Print("\n")
+var grupoTiposBasicos = Grupo{
+	Nome: "Tipos Básicos",
+	Topicos: []Topico{
+		{
+			ID:     "variaveis",
+			Titulo: "VARIAVEIS",
+			Doc:    "README_VARIAVEIS.md",
+			Pacote: "variaveis",
+			Licoes: []Licao{
+				{"VariavelImplicita", func(w io.Writer) {
+					fmt.Fprintln(w, idioma.T("VARIAVEL IMPLICITA:"))
+					variaveis.VariavelImplicita(w)
+					fmt.Fprint(w, "\n")
+				}},
+				{"VariavelExplicita", func(w io.Writer) {
+					fmt.Fprintln(w, idioma.T("VARIAVEL EXPLICITA:"))
+					variaveis.VariavelExplicita(w)
+				}},
+			},
+		},
+		{
+			ID:     "tiposdedados",
+			Titulo: "TIPOS DE DADOS",
+			Doc:    "README_TIPOS_DE_DADOS.md",
+			Pacote: "tiposdedados",
+			Licoes: []Licao{
+				{"Int", func(w io.Writer) { fmt.Fprintln(w, "INT:", tiposdedados.Int()) }},
+				{"Uint", func(w io.Writer) { fmt.Fprintln(w, "UINT:", tiposdedados.Uint()) }},
+				{"Float", func(w io.Writer) { fmt.Fprintln(w, "FLOAT:", tiposdedados.Float()) }},
+				{"Char", func(w io.Writer) { fmt.Fprintln(w, "CHAR:", tiposdedados.Char()) }},
+				{"Bool", func(w io.Writer) { fmt.Fprintln(w, "BOOL:", tiposdedados.Bool()) }},
+				{"Erro", func(w io.Writer) { fmt.Fprintln(w, idioma.T("ERRO:"), tiposdedados.Erro()) }},
+				{"ExploradorDeTexto", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("EXPLORADOR DE TEXTO UNICODE: "))
+					tiposdedados.ExploradorDeTexto(w)
+				}},
+				{"InspetorDeTipos", func(w io.Writer) {
+					fmt.Fprint(w, idioma.T("INSPETOR DE TIPOS NUMERICOS: "))
+					tiposdedados.InspetorDeTipos(w)
+				}},
+			},
+		},
+	},
 }
 
+func ExecutarTiposBasicos(w io.Writer) {
+	executarGrupo(saida(w), grupoTiposBasicos)
+}
@@ -4,15 +4,51 @@ import (
 	"log"
 	"net"
 	"fmt"
+	"os"
+
+	"modulo/idioma"
+	"modulo/relogio"
 
 	"github.com/urfave/cli"
 )
 
 // Gerar vai retornar a aplicacao de linha de comando
 func Gerar() *cli.App {
+	// Os textos de ajuda são montados aqui, antes do cli ler as flags,
+	// então o idioma é escolhido olhando os argumentos diretamente.
+	// Um valor inválido é informado pelo Before.
+	idioma.Configurar(idiomaDosArgumentos(os.Args[1:]))
+
 	app := cli.NewApp()
 	app.Name = "Aplicacao de Linha de Comando"
-	app.Usage = "Busca Ips e Nomes de Servidor na internet"
+	app.Usage = idioma.T("Busca Ips e Nomes de Servidor na internet")
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   "perfil",
+			Usage:  idioma.T("Aluno dono do progresso (padrao: usuario do sistema)"),
+			EnvVar: "CURSO_PERFIL",
+		},
+		cli.StringFlag{
+			Name:  "idioma",
+			Usage: idioma.T("Idioma das licoes e da ajuda: pt-BR ou en (padrao: LANG)"),
+		},
+		cli.StringFlag{
+			Name:  "velocidade",
+			Value: "real",
+			Usage: idioma.T("Velocidade das licoes que esperam (ex.: loops): real, rapida ou instantanea"),
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		if erro := idioma.Configurar(c.GlobalString("idioma")); erro != nil {
+			return cli.NewExitError(erro.Error(), 2)
+		}
+		r, erro := relogio.Velocidade(c.GlobalString("velocidade"))
+		if erro != nil {
+			return cli.NewExitError(erro.Error(), 2)
+		}
+		relogio.Definir(r)
+		return nil
+	}
 
 	flags := []cli.Flag{
 		cli.StringFlag{
@@ -24,16 +60,27 @@ func Gerar() *cli.App {
 	app.Commands = []cli.Command{
 		{
 			Name: "ip",
-			Usage: "Busca Ips de endereco na internet",
+			Usage: idioma.T("Busca Ips de endereco na internet"),
 			Flags: flags,
 			Action: buscarIps,
 		},
 		{
 			Name: "servidores",
-			Usage: "Busca o nome do servidor na internet",
+			Usage: idioma.T("Busca o nome do servidor na internet"),
 			Flags: flags,
 			Action: buscarServidor,
 		},
+		comandoExercicio(),
+		comandoQuiz(),
+		comandoLicao(),
+		comandoExpressao(),
+		comandoTabela(),
+		comandoCalcular(),
+		comandoBits(),
+		comandoTipos(),
+		comandoTexto(),
+		comandoNotas(),
+		comandoProgresso(),
 	}
 
 	return app
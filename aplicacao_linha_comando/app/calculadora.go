@@ -0,0 +1,110 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"modulo/calculadora"
+	"modulo/idioma"
+	"modulo/rastro"
+
+	"github.com/urfave/cli"
+)
+
+func comandoCalcular() cli.Command {
+	return cli.Command{
+		Name:      "calcular",
+		Usage:     idioma.T("Faz uma conta em um tipo numerico do Go, mostrando overflow, divisao inteira e arredondamento"),
+		ArgsUsage: idioma.T("[--] \"<conta>\""),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "tipo",
+				Value: "int",
+				Usage: idioma.T("Tipo usado na conta: ") + strings.Join(calculadora.Tipos(), ", "),
+			},
+			cli.StringFlag{
+				Name:  "formato",
+				Value: rastro.FormatoTexto,
+				Usage: idioma.T("Como mostrar os passos: texto, cores ou json"),
+			},
+		},
+		Action:       calcular,
+		OnUsageError: contaComoOpcao,
+	}
+}
+
+func comandoBits() cli.Command {
+	return cli.Command{
+		Name:      "bits",
+		Usage:     idioma.T("Faz uma conta em um tipo inteiro do Go, mostrando cada operacao em binario e hexadecimal"),
+		ArgsUsage: idioma.T("[--] \"<conta>\""),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "tipo",
+				Value: "uint8",
+				Usage: idioma.T("Tipo usado na conta: ") + strings.Join(calculadora.TiposInteiros(), ", "),
+			},
+			cli.StringFlag{
+				Name:  "formato",
+				Value: rastro.FormatoTexto,
+				Usage: idioma.T("Como mostrar os passos: texto, cores ou json"),
+			},
+		},
+		Action:       bits,
+		OnUsageError: contaComoOpcao,
+	}
+}
+
+// contaComoOpcao explica o -- quando a conta começa com - (ex.: "-128 / -1"):
+// sem ele, o urfave/cli lê a conta como uma opção que não existe. Os outros
+// erros de uso mostram a ajuda do comando, como o urfave/cli faria.
+func contaComoOpcao(c *cli.Context, erro error, _ bool) error {
+	opcao, ok := strings.CutPrefix(erro.Error(), "flag provided but not defined: -")
+	if ok && opcao != "" && !unicode.IsLetter(rune(opcao[0])) {
+		return cli.NewExitError(fmt.Sprintf(idioma.T("a conta começa com - e foi lida como uma opção; escreva -- antes dela (ex.: %s --tipo int8 -- \"-128 / -1\")"), c.Command.Name), 2)
+	}
+	fmt.Fprintf(c.App.Writer, idioma.T("Uso incorreto: %v\n\n"), erro)
+	cli.ShowCommandHelp(c, c.Command.Name)
+	return cli.NewExitError("", 2)
+}
+
+func calcular(c *cli.Context) error {
+	return fazerConta(c, calculadora.Tipos(), (*calculadora.Conta).Calcular)
+}
+
+func bits(c *cli.Context) error {
+	return fazerConta(c, calculadora.TiposInteiros(), (*calculadora.Conta).CalcularBits)
+}
+
+// fazerConta analisa a conta passada como argumento e a calcula com o
+// --tipo escolhido, que precisa estar entre os aceitos.
+func fazerConta(c *cli.Context, aceitos []string, calcularNo func(*calculadora.Conta, string, *rastro.Rastreador) (string, error)) error {
+	if c.NArg() != 1 {
+		return cli.NewExitError(fmt.Sprintf(idioma.T(`informe a conta entre aspas (ex.: %s --tipo int8 "127 + 1")`), c.Command.Name), 2)
+	}
+	conta, erro := calculadora.Analisar(c.Args().First())
+	if erro != nil {
+		return erroDeSintaxe(erro)
+	}
+
+	tipo := c.String("tipo")
+	if !slices.Contains(aceitos, tipo) {
+		return cli.NewExitError(fmt.Sprintf(idioma.T("tipo %q invalido (use %s)"), tipo, strings.Join(aceitos, ", ")), 2)
+	}
+	saida, erro := rastro.NovaSaida(c.String("formato"), os.Stdout)
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 2)
+	}
+	r := rastro.De(saida)
+	r.Secao(fmt.Sprintf(idioma.T("%s em %s"), conta, tipo))
+	resultado, erro := calcularNo(conta, tipo, r)
+	if erro != nil {
+		saida.Finalizar()
+		return cli.NewExitError(erro.Error(), 1)
+	}
+	r.Decidir(resultado)
+	return saida.Finalizar()
+}
@@ -0,0 +1,25 @@
+package app
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli"
+)
+
+func TestContaComoOpcao(t *testing.T) {
+	var saida bytes.Buffer
+	codigo := 0
+	errWriter, osExiter := cli.ErrWriter, cli.OsExiter
+	cli.ErrWriter, cli.OsExiter = &saida, func(c int) { codigo = c }
+	defer func() { cli.ErrWriter, cli.OsExiter = errWriter, osExiter }()
+
+	aplicacao := Gerar()
+	aplicacao.Writer = io.Discard
+	aplicacao.Run([]string{"modulo", "calcular", "--tipo", "int8", "-128 / -1"})
+	if codigo != 2 || !strings.Contains(saida.String(), `-- "-128 / -1"`) {
+		t.Errorf("conta começando com -: código %d, saída %q; esperado 2 e a dica do --", codigo, saida.String())
+	}
+}
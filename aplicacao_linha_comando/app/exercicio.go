@@ -0,0 +1,98 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"modulo/exercicios"
+	"modulo/idioma"
+	"modulo/progresso"
+
+	"github.com/urfave/cli"
+)
+
+func comandoExercicio() cli.Command {
+	return cli.Command{
+		Name:  "exercicio",
+		Usage: idioma.T("Pratica os topicos do curso com exercicios corrigidos automaticamente"),
+		Subcommands: []cli.Command{
+			{
+				Name:   "listar",
+				Usage:  idioma.T("Lista os exercicios disponiveis"),
+				Action: listarExercicios,
+			},
+			{
+				Name:      "iniciar",
+				Usage:     idioma.T("Cria o arquivo <nome>.go com a assinatura da funcao a ser implementada"),
+				ArgsUsage: idioma.T("<nome>"),
+				Action:    iniciarExercicio,
+			},
+			{
+				Name:      "verificar",
+				Usage:     idioma.T("Compila e executa a solucao contra os casos escondidos do exercicio"),
+				ArgsUsage: idioma.T("<nome> [arquivo.go]"),
+				Action:    verificarExercicio,
+			},
+		},
+	}
+}
+
+func listarExercicios(c *cli.Context) error {
+	exercicios.Listar(os.Stdout)
+	return nil
+}
+
+func buscarExercicio(c *cli.Context) (exercicios.Exercicio, error) {
+	nome := c.Args().First()
+	if nome == "" {
+		return exercicios.Exercicio{}, cli.NewExitError(idioma.T("informe o nome do exercicio (veja 'exercicio listar')"), 2)
+	}
+	exercicio, ok := exercicios.Buscar(nome)
+	if !ok {
+		return exercicios.Exercicio{}, cli.NewExitError(fmt.Sprintf(idioma.T("exercicio %q nao existe (veja 'exercicio listar')"), nome), 2)
+	}
+	return exercicio, nil
+}
+
+func iniciarExercicio(c *cli.Context) error {
+	exercicio, erro := buscarExercicio(c)
+	if erro != nil {
+		return erro
+	}
+
+	arquivo := exercicio.Nome + ".go"
+	if _, erro := os.Stat(arquivo); erro == nil {
+		return cli.NewExitError(fmt.Sprintf(idioma.T("o arquivo %s ja existe"), arquivo), 1)
+	}
+	if erro := os.WriteFile(arquivo, []byte(exercicio.Modelo()), 0o644); erro != nil {
+		return erro
+	}
+	fmt.Println(idioma.T("Arquivo criado:"), arquivo)
+	fmt.Println(idioma.T(exercicio.Enunciado))
+	return nil
+}
+
+func verificarExercicio(c *cli.Context) error {
+	exercicio, erro := buscarExercicio(c)
+	if erro != nil {
+		return erro
+	}
+
+	arquivo := c.Args().Get(1)
+	if arquivo == "" {
+		arquivo = exercicio.Nome + ".go"
+	}
+	resultado, erro := exercicios.Verificar(exercicio, arquivo)
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 1)
+	}
+	resultado.Escrever(os.Stdout)
+	if !resultado.Passou() {
+		return cli.NewExitError("", 1)
+	}
+	registrarProgresso(c, func(p *progresso.Perfil) {
+		p.MarcarExercicio(exercicio.Nome, time.Now())
+	})
+	return nil
+}
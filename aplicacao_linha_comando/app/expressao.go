@@ -0,0 +1,107 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"modulo/expressao"
+	"modulo/idioma"
+	"modulo/rastro"
+
+	"github.com/urfave/cli"
+)
+
+func comandoExpressao() cli.Command {
+	return cli.Command{
+		Name:      "expressao",
+		Usage:     idioma.T("Avalia uma expressao booleana passo a passo, mostrando o curto-circuito"),
+		ArgsUsage: idioma.T("\"<expressao>\" [nome=valor ...]"),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "formato",
+				Value: rastro.FormatoTexto,
+				Usage: idioma.T("Como mostrar os passos: texto, cores ou json"),
+			},
+		},
+		Action: avaliarExpressao,
+	}
+}
+
+// analisarExpressao lê a expressão digitada.
+func analisarExpressao(texto string) (*expressao.Expressao, error) {
+	e, erro := expressao.Analisar(texto)
+	if erro != nil {
+		return nil, erroDeSintaxe(erro)
+	}
+	return e, nil
+}
+
+// erroDeSintaxe mostra a expressão com um ^ embaixo do lugar do problema.
+func erroDeSintaxe(erro error) error {
+	var sintaxe *expressao.ErroSintaxe
+	if errors.As(erro, &sintaxe) {
+		return cli.NewExitError(fmt.Sprintf("%s\n%v", sintaxe.Marcar(), erro), 2)
+	}
+	return cli.NewExitError(erro.Error(), 2)
+}
+
+func comandoTabela() cli.Command {
+	return cli.Command{
+		Name:      "tabela",
+		Usage:     idioma.T("Mostra a tabela verdade de uma expressao; com duas, diz se sao equivalentes"),
+		ArgsUsage: idioma.T("\"<expressao>\" [\"<outra expressao>\"]"),
+		Action:    mostrarTabela,
+	}
+}
+
+func mostrarTabela(c *cli.Context) error {
+	if !c.Args().Present() || c.NArg() > 2 {
+		return cli.NewExitError(idioma.T(`informe uma ou duas expressoes (ex.: "!(a && b)" "!a || !b")`), 2)
+	}
+	var expressoes []*expressao.Expressao
+	for _, texto := range c.Args() {
+		e, erro := analisarExpressao(texto)
+		if erro != nil {
+			return erro
+		}
+		expressoes = append(expressoes, e)
+	}
+	tabela, erro := expressao.NovaTabela(expressoes...)
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 1)
+	}
+	tabela.Escrever(os.Stdout)
+	return nil
+}
+
+func avaliarExpressao(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.NewExitError(idioma.T(`informe a expressao (ex.: "a > b && (c < 10 || !d)" a=7 b=5 c=12 d=false)`), 2)
+	}
+	e, erro := analisarExpressao(c.Args().First())
+	if erro != nil {
+		return erro
+	}
+	vars, erro := expressao.LerVariaveis(c.Args().Tail())
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 2)
+	}
+
+	saida, erro := rastro.NovaSaida(c.String("formato"), os.Stdout)
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 2)
+	}
+	r := rastro.De(saida)
+	r.Secao(e.String())
+	if len(vars) > 0 {
+		r.Valores(vars.String())
+	}
+	resultado, erro := e.Avaliar(vars, r)
+	if erro != nil {
+		saida.Finalizar()
+		return cli.NewExitError(erro.Error(), 1)
+	}
+	r.Decidir(fmt.Sprint(resultado))
+	return saida.Finalizar()
+}
@@ -0,0 +1,36 @@
+package app
+
+import (
+	"slices"
+	"strings"
+)
+
+// flagsGlobaisComValor são as flags globais de Gerar que recebem um valor;
+// na forma --flag valor, o valor é pulado em vez de ser lido como o nome
+// do comando.
+var flagsGlobaisComValor = []string{"perfil", "idioma", "velocidade"}
+
+// idiomaDosArgumentos procura --idioma valor ou --idioma=valor nos
+// argumentos globais (antes do nome do comando).
+func idiomaDosArgumentos(args []string) string {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" || !strings.HasPrefix(arg, "-") {
+			break
+		}
+		nome, valor, temValor := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if nome == "idioma" {
+			if temValor {
+				return valor
+			}
+			if i+1 < len(args) {
+				return args[i+1]
+			}
+			return ""
+		}
+		if !temValor && slices.Contains(flagsGlobaisComValor, nome) {
+			i++
+		}
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package app
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli"
+)
+
+func TestIdiomaDosArgumentos(t *testing.T) {
+	casos := []struct {
+		args     []string
+		esperado string
+	}{
+		{[]string{"--idioma", "en", "--help"}, "en"},
+		{[]string{"--idioma=en", "tipos"}, "en"},
+		{[]string{"-idioma", "en"}, "en"},
+		{[]string{"--velocidade", "rapida", "--idioma", "en", "--help"}, "en"},
+		{[]string{"--perfil", "ana", "--velocidade=rapida", "--idioma=en"}, "en"},
+		{[]string{"--velocidade", "rapida", "tipos", "--idioma", "en"}, ""},
+		{[]string{"tipos", "--idioma", "en"}, ""},
+		{[]string{"--", "--idioma", "en"}, ""},
+		{[]string{"--idioma"}, ""},
+		{nil, ""},
+	}
+	for _, c := range casos {
+		if obtido := idiomaDosArgumentos(c.args); obtido != c.esperado {
+			t.Errorf("idiomaDosArgumentos(%q) = %q, esperado %q", c.args, obtido, c.esperado)
+		}
+	}
+}
+
+func TestFlagsGlobaisComValor(t *testing.T) {
+	var nomes []string
+	for _, f := range Gerar().Flags {
+		if _, ok := f.(cli.BoolFlag); !ok {
+			nomes = append(nomes, strings.Split(f.GetName(), ",")[0])
+		}
+	}
+	if !slices.Equal(nomes, flagsGlobaisComValor) {
+		t.Errorf("flags globais com valor = %q, flagsGlobaisComValor = %q", nomes, flagsGlobaisComValor)
+	}
+}
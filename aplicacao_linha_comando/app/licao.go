@@ -0,0 +1,64 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	agrupamento_modulos "modulo/agrupamento_modulos"
+	"modulo/idioma"
+	"modulo/playground"
+	"modulo/progresso"
+	"modulo/rastro"
+
+	"github.com/urfave/cli"
+)
+
+func comandoLicao() cli.Command {
+	return cli.Command{
+		Name:      "licao",
+		Usage:     idioma.T("Executa as licoes de um topico e marca como vistas no progresso"),
+		ArgsUsage: idioma.T("<topico>"),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "formato",
+				Value: rastro.FormatoTexto,
+				Usage: idioma.T("Como mostrar os passos das licoes: texto, cores ou json"),
+			},
+		},
+		Action: executarLicoes,
+	}
+}
+
+func executarLicoes(c *cli.Context) error {
+	id := c.Args().First()
+	if id == "" {
+		return cli.NewExitError(idioma.T("informe o topico (ex.: slice, ponteiro)"), 2)
+	}
+	topico, ok := agrupamento_modulos.BuscarTopico(id)
+	if !ok {
+		return cli.NewExitError(fmt.Sprintf(idioma.T("topico %q nao existe"), id), 2)
+	}
+
+	formato := c.String("formato")
+	if formato == rastro.FormatoJSON {
+		if erro := playground.EscreverPassos(os.Stdout, topico); erro != nil {
+			return erro
+		}
+	} else {
+		saida, erro := rastro.NovaSaida(formato, os.Stdout)
+		if erro != nil {
+			return cli.NewExitError(erro.Error(), 2)
+		}
+		for _, licao := range topico.Licoes {
+			licao.Executar(saida)
+		}
+	}
+
+	registrarProgresso(c, func(p *progresso.Perfil) {
+		for _, licao := range topico.Licoes {
+			p.MarcarLicao(topico.ID, licao.Nome, time.Now())
+		}
+	})
+	return nil
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"modulo/idioma"
+	"modulo/notas"
+
+	"github.com/urfave/cli"
+)
+
+func comandoNotas() cli.Command {
+	padrao := notas.RegrasPadrao()
+	return cli.Command{
+		Name:      "notas",
+		Usage:     idioma.T("Le um CSV de notas e mostra o boletim de cada aluno e as estatisticas da turma"),
+		ArgsUsage: idioma.T("<arquivo.csv ou - para a entrada padrao>"),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "pesos",
+				Usage: idioma.T("Peso de cada avaliacao, separados por virgula (ex.: 3,3,4; padrao: todos iguais)"),
+			},
+			cli.Float64Flag{
+				Name:  "media",
+				Value: padrao.MediaAprovacao,
+				Usage: idioma.T("Media para aprovar sem recuperacao"),
+			},
+			cli.Float64Flag{
+				Name:  "recuperacao",
+				Value: padrao.MediaRecuperacao,
+				Usage: idioma.T("Media minima para ter direito a recuperacao"),
+			},
+			cli.Float64Flag{
+				Name:  "final",
+				Value: padrao.MediaFinal,
+				Usage: idioma.T("Media final exigida depois da recuperacao"),
+			},
+			cli.StringFlag{
+				Name:  "arredondamento",
+				Value: string(padrao.Arredondamento),
+				Usage: idioma.T("Como arredondar as medias: ") + strings.Join(notas.Arredondamentos(), ", "),
+			},
+		},
+		Action: calcularNotas,
+	}
+}
+
+func calcularNotas(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.NewExitError(idioma.T("informe o arquivo CSV (ex.: notas turma.csv) ou - para ler da entrada padrao"), 2)
+	}
+	regras := notas.Regras{
+		MediaAprovacao:   c.Float64("media"),
+		MediaRecuperacao: c.Float64("recuperacao"),
+		MediaFinal:       c.Float64("final"),
+		Arredondamento:   notas.Arredondamento(c.String("arredondamento")),
+	}
+	if pesos := c.String("pesos"); pesos != "" {
+		for _, texto := range strings.Split(pesos, ",") {
+			peso, erro := strconv.ParseFloat(strings.TrimSpace(texto), 64)
+			if erro != nil {
+				return cli.NewExitError(fmt.Sprintf(idioma.T("peso %q invalido (ex.: --pesos 3,3,4)"), texto), 2)
+			}
+			regras.Pesos = append(regras.Pesos, peso)
+		}
+	}
+
+	var entrada io.Reader = os.Stdin
+	if nome := c.Args().First(); nome != "-" {
+		arquivo, erro := os.Open(nome)
+		if erro != nil {
+			return cli.NewExitError(erro.Error(), 1)
+		}
+		defer arquivo.Close()
+		entrada = arquivo
+	}
+	turma, erro := notas.Ler(entrada)
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 1)
+	}
+	relatorio, erro := notas.AvaliarTurma(turma, regras)
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 2)
+	}
+	return relatorio.Escrever(os.Stdout)
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"modulo/idioma"
+	"modulo/progresso"
+
+	"github.com/urfave/cli"
+)
+
+func comandoProgresso() cli.Command {
+	return cli.Command{
+		Name:   "progresso",
+		Usage:  idioma.T("Mostra as licoes vistas, os exercicios resolvidos e os placares do quiz por topico"),
+		Action: mostrarProgresso,
+	}
+}
+
+// perfil é o aluno informado em --perfil (ou CURSO_PERFIL), ou o usuário do sistema.
+func perfil(c *cli.Context) string {
+	if nome := c.GlobalString("perfil"); nome != "" {
+		return nome
+	}
+	return progresso.PerfilPadrao()
+}
+
+// registrarProgresso salva uma alteração no progresso do perfil. Uma falha ao
+// salvar só gera um aviso: o comando em si já foi concluído.
+func registrarProgresso(c *cli.Context, alterar func(*progresso.Perfil)) {
+	caminho, erro := progresso.CaminhoPadrao()
+	if erro == nil {
+		erro = progresso.Registrar(caminho, perfil(c), alterar)
+	}
+	if erro != nil {
+		fmt.Fprintln(os.Stderr, "aviso: nao foi possivel salvar o progresso:", erro)
+	}
+}
+
+func mostrarProgresso(c *cli.Context) error {
+	caminho, erro := progresso.CaminhoPadrao()
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 1)
+	}
+	dados, erro := progresso.Carregar(caminho)
+	if erro != nil {
+		return cli.NewExitError(fmt.Sprintf(idioma.T("erro ao ler %s: %v"), caminho, erro), 1)
+	}
+
+	nome := perfil(c)
+	progresso.Relatorio(os.Stdout, nome, dados.Perfil(nome))
+	return nil
+}
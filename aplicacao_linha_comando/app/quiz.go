@@ -0,0 +1,46 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"modulo/idioma"
+	"modulo/progresso"
+	"modulo/quiz"
+
+	"github.com/urfave/cli"
+)
+
+func comandoQuiz() cli.Command {
+	return cli.Command{
+		Name:  "quiz",
+		Usage: idioma.T("Perguntas de multipla escolha e de prever a saida das licoes do curso"),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "topico",
+				Usage: idioma.T("Faz apenas as perguntas de um topico (ex.: slice, ponteiro)"),
+			},
+		},
+		Action: aplicarQuiz,
+	}
+}
+
+func aplicarQuiz(c *cli.Context) error {
+	perguntas := quiz.Todas()
+	topico := c.String("topico")
+	if topico != "" {
+		perguntas = quiz.DoTopico(topico)
+		if len(perguntas) == 0 {
+			return cli.NewExitError(fmt.Sprintf(idioma.T("nao ha perguntas para o topico %q"), topico), 2)
+		}
+	}
+
+	placar, erro := quiz.Aplicar(os.Stdin, os.Stdout, perguntas)
+	if placar.Total > 0 {
+		registrarProgresso(c, func(p *progresso.Perfil) {
+			p.RegistrarQuiz(topico, placar.Acertos, placar.Total, time.Now())
+		})
+	}
+	return erro
+}
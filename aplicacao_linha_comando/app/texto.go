@@ -0,0 +1,41 @@
+package app
+
+import (
+	"os"
+	"strconv"
+
+	"modulo/idioma"
+	"modulo/texto"
+
+	"github.com/urfave/cli"
+)
+
+func comandoTexto() cli.Command {
+	return cli.Command{
+		Name:      "texto",
+		Usage:     idioma.T("Mostra bytes, runas, codificacao UTF-8 e categorias Unicode de um texto"),
+		ArgsUsage: idioma.T("\"<texto>\""),
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "escapes",
+				Usage: idioma.T("Interpreta escapes do Go no texto, como \\xff, \\u0301 e \\n"),
+			},
+		},
+		Action: explorarTexto,
+	}
+}
+
+func explorarTexto(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cli.NewExitError(idioma.T(`informe o texto entre aspas (ex.: texto "café")`), 2)
+	}
+	s := c.Args().First()
+	if c.Bool("escapes") {
+		interpretado, erro := strconv.Unquote(`"` + s + `"`)
+		if erro != nil {
+			return cli.NewExitError(idioma.T("escape invalido no texto (use escapes do Go, como \\xff ou \\u00e9)"), 2)
+		}
+		s = interpretado
+	}
+	return texto.Explorar(s).Escrever(os.Stdout)
+}
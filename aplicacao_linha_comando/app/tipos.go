@@ -0,0 +1,57 @@
+package app
+
+import (
+	"os"
+	"strings"
+
+	"modulo/calculadora"
+	"modulo/idioma"
+	"modulo/rastro"
+
+	"github.com/urfave/cli"
+)
+
+func comandoTipos() cli.Command {
+	return cli.Command{
+		Name:      "tipos",
+		Usage:     idioma.T("Mostra tamanho, limites e valor zero dos tipos numericos; com um literal, mostra a conversao para cada tipo"),
+		ArgsUsage: idioma.T("[literal]"),
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "tipo",
+				Usage: idioma.T("Tipo a mostrar (pode repetir; padrao: todos): ") + strings.Join(calculadora.Tipos(), ", "),
+			},
+			cli.StringFlag{
+				Name:  "formato",
+				Value: rastro.FormatoTexto,
+				Usage: idioma.T("Como mostrar os passos: texto, cores ou json"),
+			},
+		},
+		Action: inspecionarTipos,
+	}
+}
+
+func inspecionarTipos(c *cli.Context) error {
+	if c.NArg() > 1 {
+		return cli.NewExitError(idioma.T(`informe no maximo um literal (ex.: tipos 300)`), 2)
+	}
+	nomes := c.StringSlice("tipo")
+	for _, nome := range nomes {
+		if _, erro := calculadora.Descrever(nome); erro != nil {
+			return cli.NewExitError(erro.Error(), 2)
+		}
+	}
+	if !c.Args().Present() {
+		return calculadora.EscreverDescricoes(os.Stdout, nomes...)
+	}
+
+	saida, erro := rastro.NovaSaida(c.String("formato"), os.Stdout)
+	if erro != nil {
+		return cli.NewExitError(erro.Error(), 2)
+	}
+	if erro := calculadora.Converter(c.Args().First(), rastro.De(saida), nomes...); erro != nil {
+		saida.Finalizar()
+		return cli.NewExitError(erro.Error(), 2)
+	}
+	return saida.Finalizar()
+}
@@ -1,30 +1,33 @@
 package array
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func DeclaracaoEAtribuicaoSeparada() {
+func DeclaracaoEAtribuicaoSeparada(w io.Writer) {
 	var array [5]string
 	array[0] = "Mike"
 	array[1] = "Sophia"
 	array[2] = "Luisa"
 	array[3] = "Eduardo"
 	array[4] = "Familia"
-	fmt.Println(array)
+	fmt.Fprintln(w, array)
 
 }
 
-func DeclaracaoEInicializacaoNaMesmaLinha() {	
+func DeclaracaoEInicializacaoNaMesmaLinha(w io.Writer) {	
 	array2 := [5]string{"Mike", "Sophia", "Luisa", "Eduardo", "Familia"}
-	fmt.Println(array2)
+	fmt.Fprintln(w, array2)
 
 }
 
-func InicializacaoComTamanhoInferido() { 
+func InicializacaoComTamanhoInferido(w io.Writer) { 
 	array3 := [...]string{"Mike", "Sophia", "Luisa", "Eduardo", "Familia"}
-	fmt.Println(array3)
+	fmt.Fprintln(w, array3)
 }
 
-func InicializacaoComIndicesEspecificos() { 
+func InicializacaoComIndicesEspecificos(w io.Writer) { 
 	array4 := [5]string{1: "Sophia", 3: "Eduardo"}
-	fmt.Println(array4)
+	fmt.Fprintln(w, array4)
 }
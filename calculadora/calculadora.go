@@ -0,0 +1,176 @@
+package calculadora
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"modulo/idioma"
+	"modulo/rastro"
+)
+
+// Calcular faz a conta no tipo pedido (ex.: "int8", "float32") e emite em r
+// cada operação, com avisos de overflow, divisão truncada, sinal do resto e
+// arredondamento. Cada número da conta se comporta como uma variável do tipo,
+// como em a := int8(127); a + 1: com constantes o compilador recusaria o
+// overflow. O resultado vem formatado como o fmt do Go o mostraria. r pode ser nil.
+// As operações bit a bit mostram também os operandos e o resultado em binário.
+func (c *Conta) Calcular(tipo string, r *rastro.Rastreador) (string, error) {
+	aritmetica, ok := tipos[tipo]
+	if !ok {
+		return "", fmt.Errorf(idioma.T("tipo %q inválido (use %s)"), tipo, strings.Join(nomesDosTipos, ", "))
+	}
+	return c.calcular(calculo{tipo: tipo, aritmetica: aritmetica, r: r})
+}
+
+// CalcularBits é como Calcular, mas mostra em binário e hexadecimal todas as
+// operações, não só as bit a bit. Só aceita tipos inteiros.
+func (c *Conta) CalcularBits(tipo string, r *rastro.Rastreador) (string, error) {
+	aritmetica, ok := tipos[tipo]
+	if _, inteiro := aritmetica.(representavel); !ok || !inteiro {
+		return "", fmt.Errorf(idioma.T("tipo %q inválido (use %s)"), tipo, strings.Join(TiposInteiros(), ", "))
+	}
+	return c.calcular(calculo{tipo: tipo, aritmetica: aritmetica, r: r, todosOsBits: true})
+}
+
+// TiposInteiros lista os tipos aceitos por CalcularBits.
+func TiposInteiros() []string {
+	var nomes []string
+	for _, nome := range nomesDosTipos {
+		if _, ok := tipos[nome].(representavel); ok {
+			nomes = append(nomes, nome)
+		}
+	}
+	return nomes
+}
+
+func (c *Conta) calcular(calc calculo) (string, error) {
+	if calc.r == nil {
+		calc.r = rastro.De(io.Discard)
+	}
+	valor, err := calc.valor(c.raiz)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(valor), nil
+}
+
+// representavel é implementada pelos tipos inteiros, que mostram o padrão de
+// bits de um valor.
+type representavel interface {
+	padrao(x any) string
+}
+
+type calculo struct {
+	tipo        string
+	aritmetica  aritmetica
+	r           *rastro.Rastreador
+	todosOsBits bool
+}
+
+func (c calculo) valor(n no) (any, error) {
+	switch n := n.(type) {
+	case numero:
+		valor, aviso, err := c.aritmetica.literal(n.texto)
+		if err != nil {
+			return nil, err
+		}
+		if aviso != "" {
+			c.r.Avaliar(fmt.Sprintf(idioma.T("Guarda %s em um %s"), n.texto, c.tipo), fmt.Sprintf("%s(%s)", c.tipo, n.texto), valor)
+			c.r.Avisar(aviso)
+		}
+		return valor, nil
+	case unario:
+		x, err := c.numerico(n.x, n.op)
+		if err != nil {
+			return nil, err
+		}
+		valor, aviso, err := c.aritmetica.unario(n.op, x)
+		if err != nil {
+			return nil, err
+		}
+		c.passo(n.texto, n.op+operando(x), valor, aviso, c.emBits(n.op, valor, x))
+		return valor, nil
+	case binario:
+		x, err := c.numerico(n.esq, n.op)
+		if err != nil {
+			return nil, err
+		}
+		y, err := c.numerico(n.dir, n.op)
+		if err != nil {
+			return nil, err
+		}
+		var valor any
+		aviso := ""
+		if relacional(n.op) {
+			valor = c.aritmetica.comparar(n.op, x, y)
+		} else if valor, aviso, err = c.aritmetica.binario(n.op, x, y); err != nil {
+			return nil, fmt.Errorf("%s: %w", n.texto, err)
+		}
+		c.passo(n.texto, fmt.Sprintf("%v %s %v", x, n.op, y), valor, aviso, c.emBits(n.op, valor, x, y))
+		return valor, nil
+	}
+	panic(fmt.Sprintf("calculadora: nó desconhecido %T", n))
+}
+
+// operando escreve x depois de um operador unário. Um valor com sinal vai
+// entre parênteses, e -(-128) não aparece como --128.
+func operando(x any) string {
+	s := fmt.Sprint(x)
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		return "(" + s + ")"
+	}
+	return s
+}
+
+// numerico avalia um operando de op, que não pode ser o bool de uma comparação.
+func (c calculo) numerico(n no, op string) (any, error) {
+	valor, err := c.valor(n)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := valor.(bool); ok {
+		return nil, fmt.Errorf(idioma.T("%s é bool e o operador %s precisa de %s"), n.fonte(), op, c.tipo)
+	}
+	return valor, nil
+}
+
+func (c calculo) passo(texto, conta string, valor any, aviso string, bits []string) {
+	c.r.Avaliar(fmt.Sprintf(idioma.T("Calcula %s"), texto), conta, valor)
+	if bits != nil {
+		c.r.Detalhar(bits...)
+	}
+	if aviso != "" {
+		c.r.Avisar(aviso)
+	}
+}
+
+// emBits alinha operandos e resultado de op em binário, um embaixo do outro:
+//
+//	   0000 1100  0x0C  12
+//	&  0000 1010  0x0A  10
+//	=  0000 1000  0x08  8
+//
+// Nos deslocamentos o segundo operando é só a quantidade de posições.
+func (c calculo) emBits(op string, valor any, operandos ...any) []string {
+	tipo, ok := c.aritmetica.(representavel)
+	if _, comparacao := valor.(bool); !ok || comparacao || !c.todosOsBits && !bitABit(op) {
+		return nil
+	}
+	var linhas []string
+	linha := func(prefixo string, v any) {
+		linhas = append(linhas, fmt.Sprintf("%-2s %s  %v", prefixo, tipo.padrao(v), v))
+	}
+	switch {
+	case len(operandos) == 1:
+		linha(op, operandos[0])
+	case op == "<<" || op == ">>":
+		linha("", operandos[0])
+		linhas = append(linhas, fmt.Sprintf("%-2s %v", op, operandos[1]))
+	default:
+		linha("", operandos[0])
+		linha(op, operandos[1])
+	}
+	linha("=", valor)
+	return linhas
+}
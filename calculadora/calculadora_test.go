@@ -0,0 +1,239 @@
+package calculadora
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"modulo/expressao"
+	"modulo/rastro"
+)
+
+// calcular devolve o resultado e os avisos emitidos.
+func calcular(t *testing.T, tipo, texto string) (string, []string, error) {
+	t.Helper()
+	conta, err := Analisar(texto)
+	if err != nil {
+		t.Fatalf("Analisar(%q): %v", texto, err)
+	}
+	saida := rastro.NovoJSON(nil)
+	resultado, err := conta.Calcular(tipo, rastro.De(saida))
+	var avisos []string
+	for _, p := range saida.Passos() {
+		if p.Tipo == rastro.Aviso {
+			avisos = append(avisos, p.Texto)
+		}
+	}
+	return resultado, avisos, err
+}
+
+func TestCalcular(t *testing.T) {
+	casos := []struct {
+		tipo, conta, esperado, aviso string
+	}{
+		{"int", "2 + 3 * 4", "14", ""},
+		{"int", "(2 + 3) * 4", "20", ""},
+		{"int", "10 - 4 - 3", "3", ""},
+		{"int", "7 / 2", "3", "divisão inteira"},
+		{"int", "-7 / 2", "-3", "divisão inteira"},
+		{"int", "-7 % 3", "-1", "sinal do dividendo"},
+		{"int", "7 % -3", "1", ""},
+		{"int8", "127 + 1", "-128", "overflow"},
+		{"int8", "-128 / -1", "-128", "overflow"},
+		{"int8", "-(-128)", "-128", "overflow"},
+		{"uint8", "0 - 1", "255", "overflow"},
+		{"uint8", "-(1)", "255", "não tem números negativos"},
+		{"uint8", "16 * 16", "0", "overflow"},
+		{"uint16", "0xFFFF + 1", "0", "overflow"},
+		{"int64", "0x7fffffffffffffff + 1", "-9223372036854775808", "overflow"},
+		{"uint64", "1_000 * 1_000", "1000000", ""},
+		{"int", "4.0 / 2", "2", ""},
+		{"float64", "7 / 2", "3.5", ""},
+		{"float64", "0.1 + 0.2", "0.30000000000000004", "arredondamento"},
+		{"float32", "0.1 + 0.2", "0.3", "arredondamento"},
+		{"float32", "1e38 * 10", "+Inf", "overflow"},
+		{"float64", "1 / 0.0", "+Inf", "não causa panic"},
+		{"float64", "-1 / 0.0", "-Inf", "não causa panic"},
+		{"float64", "0.5 + 0.25", "0.75", ""},
+		{"float64", "0xF0 + 0.5", "240.5", ""},
+		{"float32", "0b101 / 2", "2.5", ""},
+		{"float64", "0o17 * 2", "30", ""},
+		{"float64", "-0x10 / 4", "-4", ""},
+		{"float64", "1e-3", "0.001", "não tem representação exata"},
+		{"int", "7 > 5", "true", ""},
+		{"uint8", "200 + 100 > 250", "false", "overflow"},
+		{"float64", "0.1 + 0.2 == 0.3", "false", "arredondamento"},
+		{"float32", "0.1 + 0.2 == 0.3", "true", "arredondamento"},
+		{"uint8", "12 & 10", "8", ""},
+		{"uint8", "12 | 10", "14", ""},
+		{"uint8", "12 ^ 10", "6", ""},
+		{"uint8", "12 &^ 10", "4", ""},
+		{"uint8", "^12", "243", ""},
+		{"int8", "^0", "-1", ""},
+		{"uint8", "1 + 2 | 4 & 6", "7", ""},
+		{"uint8", "1 << 2 + 1", "5", ""},
+		{"uint8", "200 << 1", "144", "descartados"},
+		{"uint8", "1 << 8", "0", "todos os bits"},
+		{"int8", "-16 >> 2", "-4", "extensão de sinal"},
+		{"uint8", "0xF0 >> 2", "60", ""},
+	}
+	for _, c := range casos {
+		resultado, avisos, err := calcular(t, c.tipo, c.conta)
+		if err != nil {
+			t.Errorf("%s em %s: %v", c.conta, c.tipo, err)
+			continue
+		}
+		if resultado != c.esperado {
+			t.Errorf("%s em %s = %s, esperado %s", c.conta, c.tipo, resultado, c.esperado)
+		}
+		todos := strings.Join(avisos, "\n")
+		if c.aviso == "" && todos != "" || !strings.Contains(todos, c.aviso) {
+			t.Errorf("%s em %s: avisos %q, esperado %q", c.conta, c.tipo, avisos, c.aviso)
+		}
+	}
+}
+
+func TestCalcularErros(t *testing.T) {
+	casos := []struct {
+		tipo, conta, erro string
+	}{
+		{"int", "7 / 0", "divisão por zero"},
+		{"uint8", "5 % 0", "divisão por zero"},
+		{"float64", "7.5 % 2", "math.Mod"},
+		{"int8", "300", "estoura int8"},
+		{"uint8", "-1", "estoura uint8"},
+		{"int", "1.5", "não é inteiro"},
+		{"float32", "1e39", "estoura float32"},
+		{"int", "(1 < 2) + 1", "é bool"},
+		{"int", "1 << -1", "deslocamento negativo"},
+		{"float64", "1 << 3", "só existe para inteiros"},
+		{"float64", "^1.0", "só existe para inteiros"},
+		{"int", "1 + 1", ""},
+	}
+	for _, c := range casos {
+		_, _, err := calcular(t, c.tipo, c.conta)
+		if c.erro == "" {
+			if err != nil {
+				t.Errorf("%s em %s: %v", c.conta, c.tipo, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.erro) {
+			t.Errorf("%s em %s: erro = %v, esperado %q", c.conta, c.tipo, err, c.erro)
+		}
+	}
+
+	conta, _ := Analisar("1 + 1")
+	if _, err := conta.Calcular("complex128", nil); err == nil {
+		t.Error("esperava erro para tipo inválido")
+	}
+}
+
+func TestCalcularBits(t *testing.T) {
+	conta, _ := Analisar("12 & 10 + 1")
+	saida := rastro.NovoJSON(nil)
+	if _, err := conta.CalcularBits("uint8", rastro.De(saida)); err != nil {
+		t.Fatal(err)
+	}
+	var detalhes []string
+	for _, p := range saida.Passos() {
+		if p.Tipo == rastro.Detalhe {
+			detalhes = append(detalhes, p.Texto)
+		}
+	}
+	esperado := []string{
+		"   0000 1100  0x0C  12\n&  0000 1010  0x0A  10\n=  0000 1000  0x08  8",
+		"   0000 1000  0x08  8\n+  0000 0001  0x01  1\n=  0000 1001  0x09  9",
+	}
+	if !slices.Equal(detalhes, esperado) {
+		t.Errorf("detalhes = %q\nesperado %q", detalhes, esperado)
+	}
+
+	saida = rastro.NovoJSON(nil)
+	conta.Calcular("uint8", rastro.De(saida))
+	if n := len(saida.Passos()); n != 3 {
+		t.Errorf("Calcular emitiu %d passos, esperado 3 (só & mostra os bits)", n)
+	}
+	if _, err := conta.CalcularBits("float64", nil); err == nil {
+		t.Error("esperava erro para tipo não inteiro")
+	}
+}
+
+func TestPadraoDeBits(t *testing.T) {
+	casos := []struct {
+		tipo     string
+		valor    any
+		esperado string
+	}{
+		{"int8", int8(-1), "1111 1111  0xFF"},
+		{"int16", int16(-256), "1111 1111 0000 0000  0xFF00"},
+		{"uint64", uint64(1) << 63, "1000" + strings.Repeat(" 0000", 15) + "  0x8000000000000000"},
+	}
+	for _, c := range casos {
+		if s := tipos[c.tipo].(representavel).padrao(c.valor); s != c.esperado {
+			t.Errorf("padrao(%v) em %s = %q, esperado %q", c.valor, c.tipo, s, c.esperado)
+		}
+	}
+}
+
+func TestAnalisarErros(t *testing.T) {
+	casos := map[string]int{
+		"1 +":    3,
+		"(1 + 2": 6,
+		"a + 1":  0,
+		"1 $ 2":  2,
+		"1 2":    2,
+	}
+	for texto, posicao := range casos {
+		_, err := Analisar(texto)
+		var sintaxe *expressao.ErroSintaxe
+		if !errors.As(err, &sintaxe) || sintaxe.Posicao != posicao {
+			t.Errorf("Analisar(%q) = %v, esperava erro na posição %d", texto, err, posicao)
+		}
+	}
+}
+
+func TestLiteraisDoGo(t *testing.T) {
+	casos := map[string]string{
+		"0b1010 + 0o17": "25",
+		"0x1e-3":        "27",
+		"1e3 - 1":       "999",
+		"-5 - -5":       "0",
+	}
+	for texto, esperado := range casos {
+		resultado, _, err := calcular(t, "int", texto)
+		if err != nil || resultado != esperado {
+			t.Errorf("%s = %s, %v; esperado %s", texto, resultado, err, esperado)
+		}
+	}
+}
+
+func TestNegacaoDeNegativo(t *testing.T) {
+	casos := map[string]string{
+		"-(-128)":  "-(-128)",
+		"- -128":   "-(-128)",
+		"-(-(-5))": "-5",
+		"^-1":      "^(-1)",
+		"-(5)":     "-5",
+	}
+	for texto, esperado := range casos {
+		conta, err := Analisar(texto)
+		if err != nil {
+			t.Fatalf("Analisar(%q): %v", texto, err)
+		}
+		saida := rastro.NovoJSON(nil)
+		if _, err := conta.Calcular("int8", rastro.De(saida)); err != nil {
+			t.Fatalf("%s: %v", texto, err)
+		}
+		var ultima string
+		for _, p := range saida.Passos() {
+			if p.Tipo == rastro.Avaliacao {
+				ultima = p.Expressao
+			}
+		}
+		if ultima != esperado {
+			t.Errorf("%s: última operação mostrada como %q, esperado %q", texto, ultima, esperado)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package calculadora
+
+import (
+	"fmt"
+	"go/constant"
+	gotoken "go/token"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"modulo/idioma"
+	"modulo/rastro"
+)
+
+// Descricao resume um tipo numérico: quanto ele ocupa e que valores guarda.
+type Descricao struct {
+	Tipo           string
+	Bytes          int
+	Minimo, Maximo string
+	Zero           string
+}
+
+// Descrever devolve o tamanho, os limites e o valor zero de um dos Tipos.
+func Descrever(tipo string) (Descricao, error) {
+	aritmetica, ok := tipos[tipo]
+	if !ok {
+		return Descricao{}, fmt.Errorf(idioma.T("tipo %q inválido (use %s)"), tipo, strings.Join(nomesDosTipos, ", "))
+	}
+	return aritmetica.descrever(), nil
+}
+
+// EscreverDescricoes mostra em w uma tabela com a Descricao de cada tipo,
+// ou de todos os Tipos quando nenhum é pedido.
+func EscreverDescricoes(w io.Writer, nomes ...string) error {
+	if len(nomes) == 0 {
+		nomes = nomesDosTipos
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, idioma.T("TIPO\tBYTES\tMÍNIMO\tMÁXIMO\tZERO"))
+	for _, nome := range nomes {
+		d, err := Descrever(nome)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\n", d.Tipo, d.Bytes, d.Minimo, d.Maximo, d.Zero)
+	}
+	return tw.Flush()
+}
+
+// Converter leva o literal para cada tipo pedido (todos os Tipos quando
+// nenhum é pedido) de duas formas e emite em r o que acontece em cada uma:
+// como constante, T(literal), o compilador recusa o que não cabe; como
+// variável, v := literal; T(v), a conversão acontece com o programa rodando
+// e o valor dá a volta, perde a parte fracionária ou é arredondado.
+func Converter(literal string, r *rastro.Rastreador, nomes ...string) error {
+	literal = strings.TrimSpace(literal)
+	if len(nomes) == 0 {
+		nomes = nomesDosTipos
+	}
+	for _, nome := range nomes {
+		if _, ok := tipos[nome]; !ok {
+			return fmt.Errorf(idioma.T("tipo %q inválido (use %s)"), nome, strings.Join(nomesDosTipos, ", "))
+		}
+	}
+	origem, tipoOrigem, err := variavel(literal)
+	if tipoOrigem == "" {
+		return err
+	}
+	if r == nil {
+		r = rastro.De(io.Discard)
+	}
+
+	r.Valores(fmt.Sprintf("v := %s", literal))
+	if err != nil {
+		r.Mensagem("❌ " + err.Error())
+	} else {
+		r.Valores(fmt.Sprintf(idioma.T("v é um %s e vale %v"), tipoOrigem, origem))
+	}
+	for _, nome := range nomes {
+		aritmetica := tipos[nome]
+		r.Cenario(nome)
+		constante := fmt.Sprintf("%s(%s)", nome, literal)
+		if valor, aviso, err := aritmetica.literal(literal); err != nil {
+			r.Avaliar(idioma.T("Converte a constante"), constante, idioma.T("não compila"))
+			r.Detalhar("❌ " + err.Error())
+		} else {
+			r.Avaliar(idioma.T("Converte a constante"), constante, valor)
+			if aviso != "" {
+				r.Avisar(aviso)
+			}
+		}
+		if origem == nil {
+			continue
+		}
+		conversao := fmt.Sprintf("%s(v)", nome)
+		valor, aviso := aritmetica.converter(origem)
+		if valor == nil {
+			r.Avaliar(idioma.T("Converte a variável"), conversao, idioma.T("indefinido"))
+		} else {
+			r.Avaliar(idioma.T("Converte a variável"), conversao, valor)
+		}
+		if aviso != "" {
+			r.Avisar(aviso)
+		}
+	}
+	return nil
+}
+
+// variavel faz v := literal: um literal inteiro vira int e os demais, float64.
+// Se o valor não cabe nesse tipo, v := literal não compila e o erro diz por
+// quê; se o literal nem é um número do Go, o tipo volta vazio.
+func variavel(literal string) (any, string, error) {
+	valor, tipo := literalDoGo(literal)
+	switch tipo {
+	case gotoken.INT:
+		n, exato := constant.Int64Val(valor)
+		if !exato || strconv.IntSize == 32 && (n < math.MinInt32 || n > math.MaxInt32) {
+			return nil, "int", fmt.Errorf(idioma.T("v := %s não compila: a constante estoura int (constant overflows int)"), literal)
+		}
+		return int(n), "int", nil
+	case gotoken.FLOAT:
+		f, _ := constant.Float64Val(valor)
+		if math.IsInf(f, 0) {
+			return nil, "float64", fmt.Errorf(idioma.T("v := %s não compila: a constante estoura float64 (constant overflows float64)"), literal)
+		}
+		return f, "float64", nil
+	}
+	return nil, "", fmt.Errorf(idioma.T("v := %s não compila: %s não é um literal numérico do Go"), literal, literal)
+}
+
+func (t inteiros[T]) descrever() Descricao {
+	minimo, maximo := t.limites()
+	var zero T
+	return Descricao{Tipo: t.nome, Bytes: t.bits / 8, Minimo: minimo.String(), Maximo: maximo.String(), Zero: fmt.Sprint(zero)}
+}
+
+// converter faz T(v) para v int ou float64. Um float fora da faixa do
+// inteiro não tem resultado definido pela especificação e devolve nil.
+func (t inteiros[T]) converter(origem any) (any, string) {
+	minimo, maximo := t.limites()
+	switch v := origem.(type) {
+	case int:
+		r := T(v)
+		if exato := big.NewInt(int64(v)); t.grande(r).Cmp(exato) != 0 {
+			return r, fmt.Sprintf(idioma.T("%v não cabe em %s (de %s a %s): os bits que sobram são descartados e o valor dá a volta para %v"), v, t.nome, minimo, maximo, r)
+		}
+		return r, ""
+	case float64:
+		inteira, _ := new(big.Float).SetFloat64(math.Trunc(v)).Int(nil)
+		if inteira.Cmp(minimo) < 0 || inteira.Cmp(maximo) > 0 {
+			return nil, fmt.Sprintf(idioma.T("%v está fora da faixa de %s (de %s a %s): a especificação do Go não define o resultado, que muda de uma plataforma para outra"), v, t.nome, minimo, maximo)
+		}
+		r := T(v)
+		if math.Trunc(v) != v {
+			return r, fmt.Sprintf(idioma.T("a parte fracionária é descartada (trunca em direção a zero): %v vira %v"), v, r)
+		}
+		return r, ""
+	}
+	panic(fmt.Sprintf("calculadora: origem desconhecida %T", origem))
+}
+
+func (t flutuantes[T]) descrever() Descricao {
+	maximo := T(maximoFloat(t.bits))
+	var zero T
+	return Descricao{Tipo: t.nome, Bytes: t.bits / 8, Minimo: fmt.Sprint(-maximo), Maximo: fmt.Sprint(maximo), Zero: fmt.Sprint(zero)}
+}
+
+// converter faz T(v) para v int ou float64, avisando quando o valor guardado
+// não é o original.
+func (t flutuantes[T]) converter(origem any) (any, string) {
+	var r T
+	exato := new(big.Rat)
+	switch v := origem.(type) {
+	case int:
+		r = T(v)
+		exato.SetInt64(int64(v))
+	case float64:
+		r = T(v)
+		exato.SetFloat64(v)
+	default:
+		panic(fmt.Sprintf("calculadora: origem desconhecida %T", origem))
+	}
+	if !finito(r) {
+		return r, fmt.Sprintf(idioma.T("overflow: %s passa do maior %s e vira %v"), decimal(exato), t.nome, r)
+	}
+	if exato.Cmp(racional(r)) != 0 {
+		return r, fmt.Sprintf(idioma.T("arredondamento: %s não cabe exatamente em %s e vira o valor mais próximo, %s"), decimal(exato), t.nome, detalhado(r))
+	}
+	return r, ""
+}
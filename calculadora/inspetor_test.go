@@ -0,0 +1,105 @@
+package calculadora
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"modulo/rastro"
+)
+
+func TestDescrever(t *testing.T) {
+	casos := map[string]Descricao{
+		"int8":    {"int8", 1, "-128", "127", "0"},
+		"uint16":  {"uint16", 2, "0", "65535", "0"},
+		"uint64":  {"uint64", 8, "0", "18446744073709551615", "0"},
+		"float32": {"float32", 4, "-3.4028235e+38", "3.4028235e+38", "0"},
+	}
+	for tipo, esperado := range casos {
+		d, err := Descrever(tipo)
+		if err != nil || d != esperado {
+			t.Errorf("Descrever(%s) = %+v, %v; esperado %+v", tipo, d, err, esperado)
+		}
+	}
+	if _, err := Descrever("complex64"); err == nil {
+		t.Error("esperava erro para tipo inválido")
+	}
+}
+
+// converter devolve, para cada tipo, as avaliações e os avisos emitidos.
+func converter(t *testing.T, literal string, tipos ...string) map[string][]string {
+	t.Helper()
+	saida := rastro.NovoJSON(nil)
+	if err := Converter(literal, rastro.De(saida), tipos...); err != nil {
+		t.Fatalf("Converter(%q): %v", literal, err)
+	}
+	passos := map[string][]string{}
+	tipo := ""
+	for _, p := range saida.Passos() {
+		switch p.Tipo {
+		case rastro.Cenario:
+			tipo = p.Texto
+		case rastro.Avaliacao:
+			passos[tipo] = append(passos[tipo], fmt.Sprintf("%s = %s", p.Expressao, p.Valor))
+		case rastro.Aviso, rastro.Detalhe:
+			passos[tipo] = append(passos[tipo], p.Texto)
+		}
+	}
+	return passos
+}
+
+func TestConverter(t *testing.T) {
+	casos := []struct {
+		literal, tipo string
+		esperado      []string
+	}{
+		{"300", "uint8", []string{"uint8(300) = não compila", "overflows uint8", "uint8(v) = 44", "dá a volta"}},
+		{"-1", "uint16", []string{"uint16(-1) = não compila", "uint16(v) = 65535"}},
+		{"-1", "int8", []string{"int8(-1) = -1", "int8(v) = -1"}},
+		{"3.99", "int", []string{"int(3.99) = não compila", "truncated", "int(v) = 3", "parte fracionária"}},
+		{"-3.99", "int8", []string{"int8(v) = -3"}},
+		{"1e10", "int8", []string{"int8(v) = indefinido", "não define"}},
+		{"4.0", "int8", []string{"int8(4.0) = 4", "int8(v) = 4"}},
+		{"0.1", "float32", []string{"float32(0.1) = 0.1", "representação exata", "float32(v) = 0.1", "arredondamento"}},
+		{"1e39", "float32", []string{"float32(1e39) = não compila", "float32(v) = +Inf", "overflow"}},
+		{"16777217", "float32", []string{"float32(v) = 1.6777216e+07", "arredondamento"}},
+		{"0x10", "float64", []string{"float64(0x10) = 16", "float64(v) = 16"}},
+		{"0b101", "float32", []string{"float32(0b101) = 5"}},
+		{"0x1p-2", "float64", []string{"float64(0x1p-2) = 0.25", "float64(v) = 0.25"}},
+		{"1_000", "uint16", []string{"uint16(1_000) = 1000"}},
+		{"1e3", "int16", []string{"int16(1e3) = 1000", "int16(v) = 1000"}},
+	}
+	for _, c := range casos {
+		passos := strings.Join(converter(t, c.literal, c.tipo)[c.tipo], "\n")
+		for _, trecho := range c.esperado {
+			if !strings.Contains(passos, trecho) {
+				t.Errorf("%s para %s: passos não contêm %q:\n%s", c.literal, c.tipo, trecho, passos)
+			}
+		}
+	}
+}
+
+func TestConverterSemVariavel(t *testing.T) {
+	passos := converter(t, "99999999999999999999", "uint64", "float64")
+	for tipo, p := range passos {
+		if texto := strings.Join(p, "\n"); strings.Contains(texto, tipo+"(v)") {
+			t.Errorf("v := literal não compila, então só a constante deveria ser convertida:\n%s", texto)
+		}
+	}
+	if len(passos) != 2 {
+		t.Errorf("passos = %q, esperado os dois tipos", passos)
+	}
+}
+
+func TestConverterErros(t *testing.T) {
+	// nenhum é um literal numérico do Go, embora strconv.ParseFloat aceite
+	// NaN, Inf e infinity
+	for _, literal := range []string{"abc", "NaN", "Inf", "-Inf", "infinity", "1e", "0x"} {
+		if err := Converter(literal, nil); err == nil || !strings.Contains(err.Error(), "não compila") {
+			t.Errorf("Converter(%q) = %v, esperado erro de que v := %s não compila", literal, err, literal)
+		}
+	}
+	if err := Converter("1", nil, "byte"); err == nil {
+		t.Error("esperava erro para tipo inválido")
+	}
+}
@@ -0,0 +1,264 @@
+package calculadora
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"modulo/expressao"
+	"modulo/idioma"
+)
+
+type tipoToken int
+
+const (
+	tokFim tipoToken = iota
+	tokNumero
+	tokOperador
+)
+
+type token struct {
+	tipo  tipoToken
+	texto string
+	pos   int
+}
+
+// precedencia segue a tabela do Go: quanto maior, mais cedo o operador é aplicado.
+var precedencia = map[string]int{
+	"==": 1, "!=": 1, "<": 1, "<=": 1, ">": 1, ">=": 1,
+	"+": 2, "-": 2, "|": 2, "^": 2,
+	"*": 3, "/": 3, "%": 3, "<<": 3, ">>": 3, "&": 3, "&^": 3,
+}
+
+func relacional(op string) bool {
+	return precedencia[op] == 1
+}
+
+// bitABit diz se op trabalha com os bits dos operandos.
+func bitABit(op string) bool {
+	switch op {
+	case "&", "|", "^", "&^", "<<", ">>":
+		return true
+	}
+	return false
+}
+
+// simbolos aceitos, com os de dois caracteres antes.
+var simbolos = []string{"&^", "<<", ">>", "==", "!=", "<=", ">=", "<", ">", "+", "-", "*", "/", "%", "&", "|", "^", "(", ")"}
+
+func separar(texto string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(texto); {
+		c, tamanho := utf8.DecodeRuneInString(texto[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += tamanho
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(texto) && texto[i+1] >= '0' && texto[i+1] <= '9':
+			inicio := i
+			for i < len(texto) && parteDoNumero(texto, i) {
+				i++
+			}
+			tokens = append(tokens, token{tokNumero, texto[inicio:i], inicio})
+		case unicode.IsLetter(c) || c == '_':
+			return nil, &expressao.ErroSintaxe{Texto: texto, Posicao: i, Mensagem: idioma.T("a calculadora só aceita números (sem variáveis)")}
+		default:
+			simbolo := ""
+			for _, s := range simbolos {
+				if strings.HasPrefix(texto[i:], s) {
+					simbolo = s
+					break
+				}
+			}
+			if simbolo == "" {
+				return nil, &expressao.ErroSintaxe{Texto: texto, Posicao: i, Mensagem: fmt.Sprintf(idioma.T("caractere %q inesperado"), c)}
+			}
+			tokens = append(tokens, token{tokOperador, simbolo, i})
+			i += len(simbolo)
+		}
+	}
+	return append(tokens, token{tokFim, "", len(texto)}), nil
+}
+
+// parteDoNumero aceita os literais do Go: 255, 1_000, 0xFF, 0b1010, 1.5, 1e-3.
+func parteDoNumero(texto string, i int) bool {
+	c := texto[i]
+	switch {
+	case c >= '0' && c <= '9', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_', c == '.':
+		return true
+	case c == '+' || c == '-':
+		// Só o sinal de um expoente, como em 1e-3 (mas não em 0x1e-3, que é uma subtração).
+		anterior := texto[i-1]
+		hexa := strings.HasPrefix(strings.ToLower(numeroAte(texto, i)), "0x")
+		return (anterior == 'e' || anterior == 'E') && !hexa || (anterior == 'p' || anterior == 'P') && hexa
+	}
+	return false
+}
+
+// numeroAte devolve o número que termina logo antes de i.
+func numeroAte(texto string, i int) string {
+	inicio := i
+	for inicio > 0 && strings.IndexByte("0123456789abcdefxABCDEFX_.", texto[inicio-1]) >= 0 {
+		inicio--
+	}
+	return texto[inicio:i]
+}
+
+// no é uma parte da conta; texto é o trecho original que ela ocupa.
+type no interface {
+	fonte() string
+}
+
+type numero struct {
+	texto string
+}
+
+type unario struct {
+	op    string
+	x     no
+	texto string
+}
+
+type binario struct {
+	op       string
+	esq, dir no
+	texto    string
+}
+
+func (n numero) fonte() string  { return n.texto }
+func (n unario) fonte() string  { return n.texto }
+func (n binario) fonte() string { return n.texto }
+
+// Conta é uma expressão aritmética ou relacional já analisada.
+type Conta struct {
+	texto string
+	raiz  no
+}
+
+// Analisar lê uma conta como "127 + 1", "0.1 + 0.2 == 0.3" ou "0xF0 >> 2".
+// São aceitos os literais numéricos do Go, parênteses, os operadores unários
+// + - ^, os aritméticos + - * / %, os bit a bit & | ^ &^ << >> e os
+// relacionais, com a precedência do Go.
+func Analisar(texto string) (*Conta, error) {
+	tokens, err := separar(texto)
+	if err != nil {
+		return nil, err
+	}
+	a := &analisador{texto: texto, tokens: tokens}
+	raiz, err := a.binario(1)
+	if err != nil {
+		return nil, err
+	}
+	if t := a.atual(); t.tipo != tokFim {
+		return nil, a.erro(t, fmt.Sprintf(idioma.T("%q inesperado depois da conta"), t.texto))
+	}
+	return &Conta{texto: strings.TrimSpace(texto), raiz: raiz}, nil
+}
+
+// String devolve a conta como foi escrita.
+func (c *Conta) String() string {
+	return c.texto
+}
+
+type analisador struct {
+	texto  string
+	tokens []token
+	i      int
+	fim    int
+}
+
+func (a *analisador) atual() token {
+	return a.tokens[a.i]
+}
+
+func (a *analisador) avancar() token {
+	t := a.tokens[a.i]
+	if t.tipo != tokFim {
+		a.i++
+		a.fim = t.pos + len(t.texto)
+	}
+	return t
+}
+
+func (a *analisador) trecho(inicio int) string {
+	return strings.TrimSpace(a.texto[inicio:a.fim])
+}
+
+func (a *analisador) erro(t token, mensagem string) error {
+	return &expressao.ErroSintaxe{Texto: a.texto, Posicao: t.pos, Mensagem: mensagem}
+}
+
+// binario lê operadores com precedência mínima minima, da esquerda para a direita.
+func (a *analisador) binario(minima int) (no, error) {
+	inicio := a.atual().pos
+	esq, err := a.unario()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := a.atual()
+		p, ok := precedencia[t.texto]
+		if t.tipo != tokOperador || !ok || p < minima {
+			return esq, nil
+		}
+		a.avancar()
+		dir, err := a.binario(p + 1)
+		if err != nil {
+			return nil, err
+		}
+		esq = binario{op: t.texto, esq: esq, dir: dir, texto: a.trecho(inicio)}
+	}
+}
+
+// unario: ("+" | "-" | "^") unario | primario. Um - colado em um número faz parte
+// do literal, como em int8(-128); antes de outra coisa ele é uma operação.
+func (a *analisador) unario() (no, error) {
+	t := a.atual()
+	if t.tipo != tokOperador || t.texto != "+" && t.texto != "-" && t.texto != "^" {
+		return a.primario()
+	}
+	a.avancar()
+	if t.texto == "-" && a.atual().tipo == tokNumero {
+		return numero{texto: "-" + a.avancar().texto}, nil
+	}
+	x, err := a.unario()
+	if err != nil {
+		return nil, err
+	}
+	return unario{op: t.texto, x: x, texto: a.trecho(t.pos)}, nil
+}
+
+func (a *analisador) primario() (no, error) {
+	t := a.atual()
+	switch {
+	case t.tipo == tokNumero:
+		a.avancar()
+		return numero{texto: t.texto}, nil
+	case t.tipo == tokOperador && t.texto == "(":
+		a.avancar()
+		dentro, err := a.binario(1)
+		if err != nil {
+			return nil, err
+		}
+		if a.atual().texto != ")" {
+			return nil, a.erro(a.atual(), idioma.T("esperava )"))
+		}
+		a.avancar()
+		return comParenteses(dentro, a.trecho(t.pos)), nil
+	case t.tipo == tokFim:
+		return nil, a.erro(t, idioma.T("a conta terminou antes do esperado"))
+	}
+	return nil, a.erro(t, fmt.Sprintf(idioma.T("%q inesperado"), t.texto))
+}
+
+func comParenteses(n no, texto string) no {
+	switch n := n.(type) {
+	case unario:
+		n.texto = texto
+		return n
+	case binario:
+		n.texto = texto
+		return n
+	}
+	return n
+}
@@ -0,0 +1,386 @@
+package calculadora
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"go/constant"
+	"go/scanner"
+	gotoken "go/token"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"modulo/idioma"
+)
+
+// aritmetica faz as contas em um tipo do Go. Os valores circulam como any,
+// sempre com o tipo concreto (int8, float32...), e cada operação é feita
+// nesse tipo, então o resultado é exatamente o que um programa Go calcularia.
+// O aviso, quando não é vazio, explica algo que o resultado esconde.
+type aritmetica interface {
+	literal(texto string) (valor any, aviso string, err error)
+	unario(op string, x any) (valor any, aviso string, err error)
+	binario(op string, x, y any) (valor any, aviso string, err error)
+	comparar(op string, x, y any) bool
+	descrever() Descricao
+	converter(origem any) (valor any, aviso string)
+}
+
+type inteiro interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+type flutuante interface {
+	~float32 | ~float64
+}
+
+// Tipos lista os tipos aceitos por Calcular.
+func Tipos() []string {
+	return append([]string(nil), nomesDosTipos...)
+}
+
+var nomesDosTipos = []string{
+	"int", "int8", "int16", "int32", "int64",
+	"uint", "uint8", "uint16", "uint32", "uint64",
+	"float32", "float64",
+}
+
+var tipos = map[string]aritmetica{
+	"int":     inteiros[int]{"int", strconv.IntSize, true},
+	"int8":    inteiros[int8]{"int8", 8, true},
+	"int16":   inteiros[int16]{"int16", 16, true},
+	"int32":   inteiros[int32]{"int32", 32, true},
+	"int64":   inteiros[int64]{"int64", 64, true},
+	"uint":    inteiros[uint]{"uint", strconv.IntSize, false},
+	"uint8":   inteiros[uint8]{"uint8", 8, false},
+	"uint16":  inteiros[uint16]{"uint16", 16, false},
+	"uint32":  inteiros[uint32]{"uint32", 32, false},
+	"uint64":  inteiros[uint64]{"uint64", 64, false},
+	"float32": flutuantes[float32]{"float32", 32},
+	"float64": flutuantes[float64]{"float64", 64},
+}
+
+func comparar[T cmp.Ordered](op string, x, y T) bool {
+	switch op {
+	case "==":
+		return x == y
+	case "!=":
+		return x != y
+	case "<":
+		return x < y
+	case "<=":
+		return x <= y
+	case ">":
+		return x > y
+	}
+	return x >= y
+}
+
+type inteiros[T inteiro] struct {
+	nome  string
+	bits  int
+	sinal bool
+}
+
+func (t inteiros[T]) grande(v T) *big.Int {
+	if t.sinal {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func (t inteiros[T]) limites() (minimo, maximo *big.Int) {
+	if !t.sinal {
+		maximo = new(big.Int).Lsh(big.NewInt(1), uint(t.bits))
+		return big.NewInt(0), maximo.Sub(maximo, big.NewInt(1))
+	}
+	minimo = new(big.Int).Lsh(big.NewInt(1), uint(t.bits-1))
+	maximo = new(big.Int).Sub(minimo, big.NewInt(1))
+	return minimo.Neg(minimo), maximo
+}
+
+// literalDoGo lê texto como um literal numérico do Go, com o - que o
+// analisador junta a ele: inteiros em qualquer base (0x, 0o, 0b), floats
+// decimais e hexadecimais e _ entre os dígitos. O tipo volta INT, FLOAT ou,
+// quando o texto não é um literal do Go (Inf, NaN, 1.2.3), ILLEGAL.
+func literalDoGo(texto string) (constant.Value, gotoken.Token) {
+	digitos := strings.TrimPrefix(texto, "-")
+	var s scanner.Scanner
+	erros := 0
+	arquivo := gotoken.NewFileSet().AddFile("", -1, len(digitos))
+	s.Init(arquivo, []byte(digitos), func(gotoken.Position, string) { erros++ }, 0)
+	_, tipo, lido := s.Scan()
+	if erros > 0 || lido != digitos || tipo != gotoken.INT && tipo != gotoken.FLOAT {
+		return constant.MakeUnknown(), gotoken.ILLEGAL
+	}
+	valor := constant.MakeFromLiteral(lido, tipo, 0)
+	if valor.Kind() == constant.Unknown {
+		return valor, gotoken.ILLEGAL
+	}
+	if digitos != texto {
+		valor = constant.UnaryOp(gotoken.SUB, valor, 0)
+	}
+	return valor, tipo
+}
+
+func (t inteiros[T]) literal(texto string) (any, string, error) {
+	valor, tipo := literalDoGo(texto)
+	if tipo == gotoken.ILLEGAL {
+		return nil, "", fmt.Errorf(idioma.T("%s não é um número"), texto)
+	}
+	valor = constant.ToInt(valor)
+	if valor.Kind() != constant.Int {
+		return nil, "", fmt.Errorf(idioma.T("%s não é inteiro e não pode ser guardado em %s (o compilador recusa: constant truncated to integer)"), texto, t.nome)
+	}
+	n, _ := new(big.Int).SetString(valor.ExactString(), 10)
+	minimo, maximo := t.limites()
+	if n.Cmp(minimo) < 0 || n.Cmp(maximo) > 0 {
+		return nil, "", fmt.Errorf(idioma.T("a constante %s estoura %s, que vai de %s a %s (o compilador recusa: constant overflows %s)"), texto, t.nome, minimo, maximo, t.nome)
+	}
+	if t.sinal {
+		return T(n.Int64()), "", nil
+	}
+	return T(n.Uint64()), "", nil
+}
+
+func (t inteiros[T]) unario(op string, x any) (any, string, error) {
+	a := x.(T)
+	switch op {
+	case "+":
+		return a, "", nil
+	case "^":
+		return ^a, "", nil
+	}
+	r := -a
+	if t.grande(r).Cmp(new(big.Int).Neg(t.grande(a))) == 0 {
+		return r, "", nil
+	}
+	if !t.sinal {
+		return r, fmt.Sprintf(idioma.T("%s não tem números negativos: -%v dá a volta e vira %v"), t.nome, a, r), nil
+	}
+	return r, t.estouro(new(big.Int).Neg(t.grande(a)), r), nil
+}
+
+func (t inteiros[T]) estouro(exato *big.Int, r T) string {
+	minimo, maximo := t.limites()
+	return fmt.Sprintf(idioma.T("overflow: o resultado exato %s não cabe em %s (de %s a %s) e dá a volta para %v"), exato, t.nome, minimo, maximo, r)
+}
+
+func (t inteiros[T]) binario(op string, x, y any) (any, string, error) {
+	a, b := x.(T), y.(T)
+	ga, gb := t.grande(a), t.grande(b)
+	if (op == "/" || op == "%") && b == 0 {
+		return nil, "", errors.New(idioma.T("divisão por zero: com inteiros o programa para com panic (runtime error: integer divide by zero)"))
+	}
+	if bitABit(op) {
+		return t.bitABit(op, a, b)
+	}
+
+	var r T
+	exato := new(big.Int)
+	switch op {
+	case "+":
+		r = a + b
+		exato.Add(ga, gb)
+	case "-":
+		r = a - b
+		exato.Sub(ga, gb)
+	case "*":
+		r = a * b
+		exato.Mul(ga, gb)
+	case "/":
+		r = a / b
+		exato.Quo(ga, gb) // Quo trunca em direção a zero, como o Go
+	case "%":
+		r = a % b
+		exato.Rem(ga, gb)
+	default:
+		return nil, "", fmt.Errorf(idioma.T("operador %s não é aritmético"), op)
+	}
+
+	switch {
+	case t.grande(r).Cmp(exato) != 0:
+		return r, t.estouro(exato, r), nil
+	case op == "/" && a%b != 0:
+		real := decimal(new(big.Rat).SetFrac(ga, gb))
+		return r, fmt.Sprintf(idioma.T("divisão inteira: %v / %v = %s, mas a parte fracionária é descartada (trunca em direção a zero)"), a, b, real), nil
+	case op == "%" && ga.Sign() < 0 && r != 0:
+		return r, fmt.Sprintf(idioma.T("o resto tem o sinal do dividendo: %v = %v * %v + (%v)"), a, a/b, b, r), nil
+	}
+	return r, "", nil
+}
+
+// bitABit faz & | ^ &^ << >>, que nunca estouram: os bits que não cabem são
+// descartados em silêncio, e o aviso conta o que se perdeu.
+func (t inteiros[T]) bitABit(op string, a, b T) (any, string, error) {
+	switch op {
+	case "&":
+		return a & b, "", nil
+	case "|":
+		return a | b, "", nil
+	case "^":
+		return a ^ b, "", nil
+	case "&^":
+		return a &^ b, "", nil
+	}
+	if b < 0 {
+		return nil, "", errors.New(idioma.T("deslocamento negativo: o programa para com panic (runtime error: negative shift amount)"))
+	}
+	n := uint64(b)
+	if op == ">>" {
+		r := a >> n
+		if a < 0 {
+			return r, fmt.Sprintf(idioma.T("%s tem sinal: >> repete o bit de sinal à esquerda (extensão de sinal), então %v >> %v = %v continua negativo"), t.nome, a, b, r), nil
+		}
+		return r, "", nil
+	}
+	r := a << n
+	if n >= uint64(t.bits) {
+		return r, fmt.Sprintf(idioma.T("deslocar %d ou mais posições tira todos os bits de um %s: o resultado é %v"), t.bits, t.nome, r), nil
+	}
+	if exato := new(big.Int).Lsh(t.grande(a), uint(n)); t.grande(r).Cmp(exato) != 0 {
+		return r, fmt.Sprintf(idioma.T("os bits que passam dos %d de %s são descartados: o resultado exato %s vira %v"), t.bits, t.nome, exato, r), nil
+	}
+	return r, "", nil
+}
+
+// padrao mostra x como o padrão de bits guardado na memória (em complemento de
+// dois, para os negativos), em grupos de 4, seguido do hexadecimal: 0000 1100  0x0C.
+func (t inteiros[T]) padrao(x any) string {
+	bits := uint64(x.(T))
+	if t.bits < 64 {
+		bits &= 1<<t.bits - 1
+	}
+	binario := fmt.Sprintf("%0*b", t.bits, bits)
+	var grupos []string
+	for i := 0; i < len(binario); i += 4 {
+		grupos = append(grupos, binario[i:i+4])
+	}
+	return fmt.Sprintf("%s  0x%0*X", strings.Join(grupos, " "), t.bits/4, bits)
+}
+
+func (t inteiros[T]) comparar(op string, x, y any) bool {
+	return comparar(op, x.(T), y.(T))
+}
+
+type flutuantes[T flutuante] struct {
+	nome string
+	bits int
+}
+
+func (t flutuantes[T]) literal(texto string) (any, string, error) {
+	valor, tipo := literalDoGo(texto)
+	if tipo == gotoken.ILLEGAL {
+		return nil, "", fmt.Errorf(idioma.T("%s não é um número"), texto)
+	}
+	var f float64
+	if t.bits == 32 {
+		f32, _ := constant.Float32Val(valor)
+		f = float64(f32)
+	} else {
+		f, _ = constant.Float64Val(valor)
+	}
+	if math.IsInf(f, 0) {
+		return nil, "", fmt.Errorf(idioma.T("a constante %s estoura %s (o maior valor é %v)"), texto, t.nome, T(maximoFloat(t.bits)))
+	}
+	v := T(f)
+	if constant.Compare(valor, gotoken.NEQ, constant.MakeFloat64(float64(v))) {
+		return v, fmt.Sprintf(idioma.T("%s não tem representação exata em %s; o valor guardado é %s"), texto, t.nome, detalhado(v)), nil
+	}
+	return v, "", nil
+}
+
+func maximoFloat(bits int) float64 {
+	if bits == 32 {
+		return math.MaxFloat32
+	}
+	return math.MaxFloat64
+}
+
+func (t flutuantes[T]) unario(op string, x any) (any, string, error) {
+	switch op {
+	case "-":
+		return -x.(T), "", nil
+	case "^":
+		return nil, "", t.soInteiros(op)
+	}
+	return x, "", nil
+}
+
+func (t flutuantes[T]) binario(op string, x, y any) (any, string, error) {
+	a, b := x.(T), y.(T)
+	var r T
+	switch op {
+	case "+":
+		r = a + b
+	case "-":
+		r = a - b
+	case "*":
+		r = a * b
+	case "/":
+		r = a / b
+	case "%":
+		return nil, "", fmt.Errorf(idioma.T("o operador %% não existe para %s (use math.Mod)"), t.nome)
+	case "&", "|", "^", "&^", "<<", ">>":
+		return nil, "", t.soInteiros(op)
+	default:
+		return nil, "", fmt.Errorf(idioma.T("operador %s não é aritmético"), op)
+	}
+
+	if !finito(a) || !finito(b) {
+		return r, "", nil
+	}
+	switch {
+	case op == "/" && b == 0:
+		return r, fmt.Sprintf(idioma.T("divisão de float por zero não causa panic: o resultado é %v"), r), nil
+	case !finito(r):
+		return r, fmt.Sprintf(idioma.T("overflow: o resultado passa do maior %s e vira %v"), t.nome, r), nil
+	}
+	exato := new(big.Rat)
+	switch op {
+	case "+":
+		exato.Add(racional(a), racional(b))
+	case "-":
+		exato.Sub(racional(a), racional(b))
+	case "*":
+		exato.Mul(racional(a), racional(b))
+	case "/":
+		exato.Quo(racional(a), racional(b))
+	}
+	if exato.Cmp(racional(r)) != 0 {
+		return r, fmt.Sprintf(idioma.T("arredondamento: o resultado exato %s não cabe em %s e vira o valor mais próximo, %s"), decimal(exato), t.nome, detalhado(r)), nil
+	}
+	return r, "", nil
+}
+
+func (t flutuantes[T]) soInteiros(op string) error {
+	return fmt.Errorf(idioma.T("o operador %s só existe para inteiros, e %s é ponto flutuante"), op, t.nome)
+}
+
+func (t flutuantes[T]) comparar(op string, x, y any) bool {
+	return comparar(op, x.(T), y.(T))
+}
+
+func finito[T flutuante](v T) bool {
+	return !math.IsInf(float64(v), 0) && !math.IsNaN(float64(v))
+}
+
+func racional[T flutuante](v T) *big.Rat {
+	return new(big.Rat).SetFloat64(float64(v))
+}
+
+// detalhado mostra um float com 20 algarismos, o bastante para ver o erro
+// de representação que o fmt esconde (0.1 vira 0.10000000000000000555).
+func detalhado[T flutuante](v T) string {
+	return strconv.FormatFloat(float64(v), 'g', 20, 64)
+}
+
+// decimal mostra um número racional com até 20 algarismos significativos.
+func decimal(r *big.Rat) string {
+	if r.IsInt() {
+		return r.RatString()
+	}
+	return new(big.Float).SetPrec(200).SetRat(r).Text('g', 20)
+}
@@ -0,0 +1,102 @@
+// Package closures reúne utilitários feitos só com closures: cada função
+// cria algumas variáveis e devolve funções que as capturam. Essas variáveis
+// continuam vivas enquanto as funções devolvidas existirem e não são
+// visíveis de nenhum outro lugar, o que faz delas um estado privado, como os
+// campos não exportados de uma struct. Todas as funções devolvidas podem ser
+// chamadas de várias goroutines ao mesmo tempo.
+package closures
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"modulo/relogio"
+)
+
+// Contador devolve duas funções que compartilham o mesmo total: incrementar
+// soma 1 e devolve o novo valor; atual só lê. Cada chamada de Contador cria
+// um total novo, independente dos outros.
+func Contador() (incrementar func() int64, atual func() int64) {
+	// atomic.Int64 em vez de int: com várias goroutines, total++ perderia
+	// incrementos
+	var total atomic.Int64
+	incrementar = func() int64 { return total.Add(1) }
+	atual = func() int64 { return total.Load() }
+	return incrementar, atual
+}
+
+// GeradorDeIDs devolve uma função que a cada chamada gera o próximo
+// identificador: o prefixo seguido de um número com pelo menos digitos
+// algarismos (ex.: "ALU-0001", "ALU-0002"). Nenhum número se repete, mesmo
+// com goroutines pedindo ao mesmo tempo.
+func GeradorDeIDs(prefixo string, digitos int) func() string {
+	var mu sync.Mutex
+	proximo := 1
+	return func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		id := fmt.Sprintf("%s%0*d", prefixo, digitos, proximo)
+		proximo++
+		return id
+	}
+}
+
+// LimitadorDeTaxa implementa um balde de fichas (token bucket): o balde
+// começa cheio, com capacidade fichas, e ganha porSegundo fichas a cada
+// segundo, sem passar da capacidade. A função devolvida gasta uma ficha e
+// devolve true, ou devolve false se o balde está vazio. Assim são aceitas
+// rajadas de até capacidade chamadas e, em média, porSegundo chamadas por
+// segundo. r nil usa relogio.Atual().
+func LimitadorDeTaxa(capacidade int, porSegundo float64, r relogio.Relogio) func() bool {
+	if r == nil {
+		r = relogio.Atual()
+	}
+	var mu sync.Mutex
+	fichas := float64(capacidade)
+	ultimaRecarga := r.Agora()
+	return func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		agora := r.Agora()
+		fichas = min(float64(capacidade), fichas+agora.Sub(ultimaRecarga).Seconds()*porSegundo)
+		ultimaRecarga = agora
+		if fichas < 1 {
+			return false
+		}
+		fichas--
+		return true
+	}
+}
+
+// UmaVez devolve uma função que chama inicializar na primeira vez e, nas
+// seguintes, devolve o mesmo resultado sem chamá-la de novo, inclusive o
+// erro. Chamadas simultâneas esperam a primeira terminar. Se inicializar
+// entrar em panic, todas as chamadas, a primeira e as seguintes, entram em
+// panic com o mesmo valor. É o que sync.OnceValues faz; aqui o sync.Once, o
+// resultado e o panic são as variáveis capturadas.
+func UmaVez[T any](inicializar func() (T, error)) func() (T, error) {
+	var (
+		once      sync.Once
+		terminou  bool
+		valor     any
+		resultado T
+		err       error
+	)
+	return func() (T, error) {
+		once.Do(func() {
+			defer func() {
+				valor = recover()
+				if !terminou {
+					panic(valor)
+				}
+			}()
+			resultado, err = inicializar()
+			terminou = true
+		})
+		if !terminou {
+			panic(valor)
+		}
+		return resultado, err
+	}
+}
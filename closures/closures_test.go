@@ -0,0 +1,183 @@
+package closures
+
+// Os testes chamam as funções de várias goroutines; rode com -race para o
+// detector de corridas conferir o estado capturado:
+//
+//	go test -race ./closures
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"modulo/relogio"
+)
+
+// emParalelo chama f de n goroutines ao mesmo tempo e espera todas.
+func emParalelo(n int, f func()) {
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestContador(t *testing.T) {
+	incrementar, atual := Contador()
+	outroIncrementar, outroAtual := Contador()
+	emParalelo(1000, func() { incrementar() })
+	outroIncrementar()
+
+	if atual() != 1000 {
+		t.Errorf("atual() = %d, esperado 1000", atual())
+	}
+	if outroAtual() != 1 {
+		t.Errorf("o segundo contador = %d, esperado 1: cada Contador tem o seu total", outroAtual())
+	}
+	if incrementar() != 1001 {
+		t.Errorf("incrementar() depois de 1000 = %d, esperado 1001", atual())
+	}
+}
+
+func TestGeradorDeIDs(t *testing.T) {
+	gerar := GeradorDeIDs("ALU-", 4)
+	if id := gerar(); id != "ALU-0001" {
+		t.Errorf("primeiro id = %q, esperado ALU-0001", id)
+	}
+
+	var mu sync.Mutex
+	vistos := map[string]bool{}
+	emParalelo(500, func() {
+		id := gerar()
+		mu.Lock()
+		defer mu.Unlock()
+		if vistos[id] {
+			t.Errorf("id repetido: %s", id)
+		}
+		vistos[id] = true
+	})
+	if len(vistos) != 500 {
+		t.Errorf("%d ids distintos, esperado 500", len(vistos))
+	}
+	if id := gerar(); id != "ALU-0502" {
+		t.Errorf("id depois de 501 = %q, esperado ALU-0502", id)
+	}
+	if id := GeradorDeIDs("", 2)(); id != "01" {
+		t.Errorf("outro gerador começa em %q, esperado 01", id)
+	}
+}
+
+func TestGeradorDeIDsPassaDosDigitos(t *testing.T) {
+	gerar := GeradorDeIDs("#", 1)
+	for range 9 {
+		gerar()
+	}
+	if id := gerar(); id != "#10" {
+		t.Errorf("décimo id = %q, esperado #10", id)
+	}
+}
+
+func TestLimitadorDeTaxa(t *testing.T) {
+	falso := relogio.NovoFalso(time.Time{})
+	permitir := LimitadorDeTaxa(3, 2, falso)
+
+	for i := range 3 {
+		if !permitir() {
+			t.Fatalf("chamada %d da rajada recusada, esperado as 3 primeiras aceitas", i+1)
+		}
+	}
+	if permitir() {
+		t.Fatal("4ª chamada aceita com o balde vazio")
+	}
+	falso.Avancar(250 * time.Millisecond) // meia ficha
+	if permitir() {
+		t.Error("aceita com meia ficha")
+	}
+	falso.Avancar(250 * time.Millisecond) // completa a ficha
+	if !permitir() {
+		t.Error("recusada depois de 500ms, esperado uma ficha nova (2 por segundo)")
+	}
+	falso.Avancar(time.Hour)
+	aceitas := 0
+	for range 10 {
+		if permitir() {
+			aceitas++
+		}
+	}
+	if aceitas != 3 {
+		t.Errorf("depois de uma hora, %d aceitas, esperado 3: o balde não passa da capacidade", aceitas)
+	}
+}
+
+func TestLimitadorDeTaxaConcorrente(t *testing.T) {
+	permitir := LimitadorDeTaxa(100, 1, relogio.NovoFalso(time.Time{}))
+	incrementar, aceitas := Contador()
+	emParalelo(300, func() {
+		if permitir() {
+			incrementar()
+		}
+	})
+	if aceitas() != 100 {
+		t.Errorf("%d aceitas, esperado exatamente 100", aceitas())
+	}
+}
+
+func TestUmaVez(t *testing.T) {
+	incrementar, chamadas := Contador()
+	configuracao := UmaVez(func() (map[string]string, error) {
+		incrementar()
+		time.Sleep(10 * time.Millisecond) // as outras goroutines chegam antes do fim
+		return map[string]string{"idioma": "pt-BR"}, nil
+	})
+	emParalelo(50, func() {
+		if c, err := configuracao(); err != nil || c["idioma"] != "pt-BR" {
+			t.Errorf("configuracao() = %v, %v", c, err)
+		}
+	})
+	if chamadas() != 1 {
+		t.Errorf("inicializar chamada %d vezes, esperado 1", chamadas())
+	}
+}
+
+func TestUmaVezGuardaOErro(t *testing.T) {
+	errFalhou := errors.New("falhou")
+	tentativas := 0
+	conectar := UmaVez(func() (int, error) {
+		tentativas++
+		return 0, errFalhou
+	})
+	for range 3 {
+		if _, err := conectar(); !errors.Is(err, errFalhou) {
+			t.Errorf("conectar() = %v, esperado errFalhou", err)
+		}
+	}
+	if tentativas != 1 {
+		t.Errorf("%d tentativas, esperado 1: o erro também fica guardado", tentativas)
+	}
+}
+
+func TestUmaVezRepeteOPanic(t *testing.T) {
+	tentativas := 0
+	carregar := UmaVez(func() (int, error) {
+		tentativas++
+		panic("arquivo corrompido")
+	})
+	for i := range 3 {
+		func() {
+			defer func() {
+				if r := recover(); r != "arquivo corrompido" {
+					t.Errorf("chamada %d: recover() = %v, esperado o panic de inicializar", i+1, r)
+				}
+			}()
+			carregar()
+		}()
+	}
+	if tentativas != 1 {
+		t.Errorf("%d tentativas, esperado 1: o panic também fica guardado", tentativas)
+	}
+}
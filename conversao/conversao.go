@@ -0,0 +1,176 @@
+// Package conversao converte números entre os tipos do Go sem perder nada em
+// silêncio. A conversão T(v) da linguagem nunca falha: o inteiro dá a volta,
+// o float perde a parte fracionária e NaN vira um número qualquer. Converter
+// faz a mesma conversão, mas devolve um *ErroConversao quando o resultado não
+// seria exatamente o valor original.
+package conversao
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"unsafe"
+
+	"modulo/idioma"
+)
+
+// Inteiro reúne os tipos inteiros do Go (e os definidos a partir deles).
+type Inteiro interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Flutuante reúne os tipos de ponto flutuante.
+type Flutuante interface {
+	~float32 | ~float64
+}
+
+// Numero é qualquer tipo que Converter aceita, de um lado ou do outro.
+type Numero interface {
+	Inteiro | Flutuante
+}
+
+// O que impede D(v) de guardar exatamente v. Converter devolve uma delas
+// como a Causa de um *ErroConversao.
+var (
+	// ErrOverflow: o valor passa do mínimo ou do máximo do tipo de destino.
+	ErrOverflow = errors.New("overflow")
+	// ErrTruncamento: o valor tem parte fracionária e o destino é inteiro.
+	ErrTruncamento = errors.New("truncamento")
+	// ErrNaoFinito: NaN ou infinito não têm inteiro correspondente.
+	ErrNaoFinito = errors.New("não finito")
+	// ErrArredondamento: o destino é float e não guarda o valor exato.
+	ErrArredondamento = errors.New("arredondamento")
+)
+
+// ErroConversao é o erro de Converter: o valor, já formatado, os nomes dos
+// dois tipos e o motivo. A mensagem lembra a conversão escrita em Go, como
+// "uint8(300) de int: não cabe no tipo".
+type ErroConversao struct {
+	Valor    string // o valor original, como o fmt o mostra
+	De, Para string // os tipos de origem e de destino
+	Causa    error
+}
+
+func (e *ErroConversao) Error() string {
+	var motivo string
+	switch e.Causa {
+	case ErrOverflow:
+		motivo = idioma.T("não cabe no tipo")
+	case ErrTruncamento:
+		motivo = idioma.T("a parte fracionária seria perdida")
+	case ErrNaoFinito:
+		motivo = idioma.T("não existe inteiro correspondente")
+	case ErrArredondamento:
+		motivo = idioma.T("o valor seria arredondado")
+	default:
+		motivo = e.Causa.Error()
+	}
+	return fmt.Sprintf("%s(%s) de %s: %s", e.Para, e.Valor, e.De, motivo)
+}
+
+// Unwrap deixa errors.Is(err, ErrOverflow) olhar a causa.
+func (e *ErroConversao) Unwrap() error {
+	return e.Causa
+}
+
+// Converter devolve v no tipo D, ou um *ErroConversao se D(v) não guardaria
+// exatamente v. NaN e infinitos passam de um float para outro sem erro.
+//
+//	n, err := conversao.Converter[uint8](300)  // 0, ErrOverflow
+//	n, err := conversao.Converter[int](3.99)   // 0, ErrTruncamento
+func Converter[D, O Numero](v O) (D, error) {
+	var r D
+	falha := func(causa error) (D, error) {
+		return 0, &ErroConversao{Valor: fmt.Sprint(v), De: nomeDoTipo(v), Para: nomeDoTipo(r), Causa: causa}
+	}
+
+	exato, finito := valorExato(v)
+	if !finito {
+		if inteiro[D]() {
+			return falha(ErrNaoFinito)
+		}
+		return D(v), nil
+	}
+	if inteiro[D]() {
+		minimo, maximo := Limites[D]()
+		parteInteira, _ := exato.Int(nil)
+		if parteInteira.Cmp(grande(minimo)) < 0 || parteInteira.Cmp(grande(maximo)) > 0 {
+			return falha(ErrOverflow)
+		}
+		if !exato.IsInt() {
+			return falha(ErrTruncamento)
+		}
+		return D(v), nil
+	}
+
+	r = D(v)
+	obtido, finito := valorExato(r)
+	if !finito {
+		return falha(ErrOverflow)
+	}
+	if obtido.Cmp(exato) != 0 {
+		return falha(ErrArredondamento)
+	}
+	return r, nil
+}
+
+// Limites devolve o menor e o maior valor de T. Para floats, o maior valor
+// finito e o seu negativo.
+func Limites[T Numero]() (minimo, maximo T) {
+	bits := 8 * unsafe.Sizeof(minimo)
+	switch {
+	case !inteiro[T]():
+		maior := math.MaxFloat64
+		if bits == 32 {
+			maior = math.MaxFloat32
+		}
+		maximo = T(maior)
+		return -maximo, maximo
+	case comSinal[T]():
+		maximo = T(uint64(1)<<(bits-1) - 1)
+		return -maximo - 1, maximo
+	}
+	return 0, T(^uint64(0) >> (64 - bits))
+}
+
+// inteiro diz se T é um tipo inteiro: só neles 1/2 dá 0.
+func inteiro[T Numero]() bool {
+	var um T = 1
+	return um/2 == 0
+}
+
+// comSinal diz se T guarda negativos: só neles 0-1 fica abaixo de zero.
+func comSinal[T Numero]() bool {
+	var zero T
+	zero--
+	return zero < 0
+}
+
+// valorExato devolve v como um big.Float sem arredondar; finito é false
+// para NaN e infinitos.
+func valorExato[T Numero](v T) (exato *big.Float, finito bool) {
+	switch {
+	case !inteiro[T]():
+		f := float64(v)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, false
+		}
+		return new(big.Float).SetFloat64(f), true
+	case comSinal[T]():
+		return new(big.Float).SetInt64(int64(v)), true
+	}
+	return new(big.Float).SetUint64(uint64(v)), true
+}
+
+// grande devolve um inteiro como big.Int, para comparar sem estourar.
+func grande[T Numero](v T) *big.Int {
+	if comSinal[T]() {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func nomeDoTipo(v any) string {
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,359 @@
+package conversao
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+	"unsafe"
+)
+
+type caso struct {
+	nome     string
+	executar func() (any, error)
+	esperado any
+	causa    error
+}
+
+// c monta um caso que converte v para D.
+func c[D, O Numero](v O, esperado D, causa error) caso {
+	var d D
+	return caso{
+		nome:     fmt.Sprintf("%T(%v) de %T", d, v, v),
+		executar: func() (any, error) { return Converter[D](v) },
+		esperado: esperado,
+		causa:    causa,
+	}
+}
+
+func TestConverter(t *testing.T) {
+	casos := []caso{
+		c[int8](int(127), int8(127), nil),
+		c[int8](int(128), int8(0), ErrOverflow),
+		c[int8](int(-128), int8(-128), nil),
+		c[int8](int(-129), int8(0), ErrOverflow),
+		c[uint8](int(-1), uint8(0), ErrOverflow),
+		c[uint8](int(255), uint8(255), nil),
+		c[uint8](int(300), uint8(0), ErrOverflow),
+		c[uint16](int8(-1), uint16(0), ErrOverflow),
+		c[int64](uint64(math.MaxInt64), int64(math.MaxInt64), nil),
+		c[int64](uint64(math.MaxInt64+1), int64(0), ErrOverflow),
+		c[uint64](int64(math.MinInt64), uint64(0), ErrOverflow),
+		c[uint32](uint64(math.MaxUint32), uint32(math.MaxUint32), nil),
+		c[uint32](uint64(math.MaxUint32+1), uint32(0), ErrOverflow),
+
+		c[int](3.99, 0, ErrTruncamento),
+		c[int](-0.5, 0, ErrTruncamento),
+		c[int](4.0, 4, nil),
+		c[int8](127.0, int8(127), nil),
+		c[int8](127.5, int8(0), ErrTruncamento),
+		c[int8](128.0, int8(0), ErrOverflow),
+		c[int8](-128.9, int8(0), ErrTruncamento),
+		c[int8](-129.0, int8(0), ErrOverflow),
+		c[uint8](-0.0, uint8(0), nil),
+		c[uint8](-0.5, uint8(0), ErrTruncamento),
+		c[uint8](-1.0, uint8(0), ErrOverflow),
+		c[int64](float64(math.MaxInt64), int64(0), ErrOverflow), // 2^63 não cabe
+		c[int64](float64(math.MinInt64), int64(math.MinInt64), nil),
+		c[uint64](float64(1<<63), uint64(1<<63), nil),
+		c[int32](float32(1e10), int32(0), ErrOverflow),
+
+		c[int](math.NaN(), 0, ErrNaoFinito),
+		c[int](math.Inf(1), 0, ErrNaoFinito),
+		c[uint8](float32(math.Inf(-1)), uint8(0), ErrNaoFinito),
+
+		c[float64](int64(1<<53), float64(1<<53), nil),
+		c[float64](int64(1<<53+1), 0.0, ErrArredondamento),
+		c[float32](int32(1<<24+1), float32(0), ErrArredondamento),
+		c[float32](int32(1<<24), float32(1<<24), nil),
+		c[float32](uint64(math.MaxUint64), float32(0), ErrArredondamento),
+		c[float32](0.1, float32(0), ErrArredondamento),
+		c[float32](0.5, float32(0.5), nil),
+		c[float32](1e39, float32(0), ErrOverflow),
+		c[float32](-1e39, float32(0), ErrOverflow),
+		c[float32](1e-50, float32(0), ErrArredondamento),
+		c[float32](float64(math.MaxFloat32), float32(math.MaxFloat32), nil),
+		c[float64](float32(0.1), float64(float32(0.1)), nil),
+		c[float32](math.Inf(-1), float32(math.Inf(-1)), nil),
+	}
+	for _, caso := range casos {
+		obtido, err := caso.executar()
+		if !errors.Is(err, caso.causa) || (caso.causa == nil) != (err == nil) {
+			t.Errorf("%s: erro = %v, esperado %v", caso.nome, err, caso.causa)
+			continue
+		}
+		if obtido != caso.esperado {
+			t.Errorf("%s = %v (%T), esperado %v (%T)", caso.nome, obtido, obtido, caso.esperado, caso.esperado)
+		}
+	}
+}
+
+func TestConverterNaN(t *testing.T) {
+	r, err := Converter[float32](math.NaN())
+	if err != nil || !math.IsNaN(float64(r)) {
+		t.Errorf("float32(NaN) = %v, %v; esperado NaN sem erro", r, err)
+	}
+}
+
+func TestErroConversao(t *testing.T) {
+	_, err := Converter[uint8](300)
+	var conversao *ErroConversao
+	if !errors.As(err, &conversao) {
+		t.Fatalf("erro %T não é *ErroConversao", err)
+	}
+	esperado := ErroConversao{Valor: "300", De: "int", Para: "uint8", Causa: ErrOverflow}
+	if *conversao != esperado {
+		t.Errorf("erro = %+v, esperado %+v", *conversao, esperado)
+	}
+
+	mensagens := []struct {
+		err      error
+		esperado string
+	}{
+		{err, "uint8(300) de int: não cabe no tipo"},
+		{segundo(Converter[int](3.99)), "int(3.99) de float64: a parte fracionária seria perdida"},
+		{segundo(Converter[int8](math.Inf(1))), "int8(+Inf) de float64: não existe inteiro correspondente"},
+		{segundo(Converter[float32](0.1)), "float32(0.1) de float64: o valor seria arredondado"},
+	}
+	for _, m := range mensagens {
+		if m.err == nil || m.err.Error() != m.esperado {
+			t.Errorf("mensagem = %v, esperado %q", m.err, m.esperado)
+		}
+	}
+}
+
+func segundo[T any](_ T, err error) error {
+	return err
+}
+
+type celsius float64
+
+type idade uint8
+
+func TestTiposDefinidos(t *testing.T) {
+	i, err := Converter[idade](celsius(36))
+	if err != nil || i != 36 {
+		t.Errorf("idade(celsius(36)) = %v, %v", i, err)
+	}
+	if _, err := Converter[idade](celsius(-40)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("idade(celsius(-40)): erro = %v, esperado overflow", err)
+	}
+}
+
+func TestLimites(t *testing.T) {
+	verificar := func(nome string, minimo, maximo, minimoEsperado, maximoEsperado any) {
+		t.Helper()
+		if minimo != minimoEsperado || maximo != maximoEsperado {
+			t.Errorf("Limites[%s]() = %v, %v; esperado %v, %v", nome, minimo, maximo, minimoEsperado, maximoEsperado)
+		}
+	}
+	a, b := Limites[int8]()
+	verificar("int8", a, b, int8(math.MinInt8), int8(math.MaxInt8))
+	c, d := Limites[int64]()
+	verificar("int64", c, d, int64(math.MinInt64), int64(math.MaxInt64))
+	e, f := Limites[uint16]()
+	verificar("uint16", e, f, uint16(0), uint16(math.MaxUint16))
+	g, h := Limites[uint64]()
+	verificar("uint64", g, h, uint64(0), uint64(math.MaxUint64))
+	i, j := Limites[float32]()
+	verificar("float32", i, j, float32(-math.MaxFloat32), float32(math.MaxFloat32))
+}
+
+// esperado calcula, sem usar Converter, o que a conversão de v para D deve
+// dar: o valor exato de v é levado ao tipo D por math/big.
+func esperado[D, O Numero](v O) (D, error) {
+	var d D
+	exato, finito := valorExato(v)
+	switch {
+	case !finito && inteiro[D]():
+		return 0, ErrNaoFinito
+	case !finito:
+		return D(v), nil
+	case inteiro[D]():
+		n, precisao := exato.Int(nil)
+		minimo, maximo := Limites[D]()
+		if n.Cmp(grande(minimo)) < 0 || n.Cmp(grande(maximo)) > 0 {
+			return 0, ErrOverflow
+		}
+		if precisao != big.Exact {
+			return 0, ErrTruncamento
+		}
+		if comSinal[D]() {
+			return D(n.Int64()), nil
+		}
+		return D(n.Uint64()), nil
+	}
+
+	var f float64
+	var precisao big.Accuracy
+	if unsafe.Sizeof(d) == 4 {
+		var f32 float32
+		f32, precisao = exato.Float32()
+		f = float64(f32)
+	} else {
+		f, precisao = exato.Float64()
+	}
+	switch {
+	case math.IsInf(f, 0):
+		return 0, ErrOverflow
+	case precisao != big.Exact:
+		return 0, ErrArredondamento
+	}
+	return D(f), nil
+}
+
+// fronteiras devolve os valores de O mais perigosos para uma conversão: os
+// limites de O, os vizinhos de zero e os limites de todos os outros tipos
+// que O consegue guardar.
+func fronteiras[O Numero]() []O {
+	minimo, maximo := Limites[O]()
+	var zero O
+	valores := []O{minimo, minimo + 1, zero, zero + 1, maximo - 1, maximo}
+	if comSinal[O]() {
+		valores = append(valores, zero-1)
+	}
+	for _, k := range []uint{7, 8, 15, 16, 24, 31, 32, 53, 63, 64} {
+		potencia := new(big.Int).Lsh(big.NewInt(1), k)
+		for _, delta := range []int64{-1, 0, 1} {
+			for _, sinal := range []int64{1, -1} {
+				n := new(big.Int).Add(potencia, big.NewInt(delta))
+				n.Mul(n, big.NewInt(sinal))
+				if v, ok := deBig[O](n); ok {
+					valores = append(valores, v)
+				}
+			}
+		}
+	}
+	if !inteiro[O]() {
+		for _, f := range []float64{0.5, -0.5, 1.5, -1.5, 127.5, -128.5, 255.99, 1e10, -1e10, 0.1, 1e-50,
+			math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat64, math.MaxFloat32, 1e39,
+			math.NaN(), math.Inf(1), math.Inf(-1)} {
+			valores = append(valores, O(f))
+		}
+	}
+	return valores
+}
+
+// deBig devolve n em O quando O guarda n, arredondando nos floats.
+func deBig[O Numero](n *big.Int) (O, bool) {
+	if !inteiro[O]() {
+		f, _ := new(big.Float).SetInt(n).Float64()
+		return O(f), true
+	}
+	minimo, maximo := Limites[O]()
+	if n.Cmp(grande(minimo)) < 0 || n.Cmp(grande(maximo)) > 0 {
+		return 0, false
+	}
+	if comSinal[O]() {
+		return O(n.Int64()), true
+	}
+	return O(n.Uint64()), true
+}
+
+// conferir compara Converter com o esperado para v.
+func conferir[D, O Numero](t *testing.T, v O) {
+	t.Helper()
+	obtido, err := Converter[D](v)
+	valor, causa := esperado[D](v)
+	var d D
+	switch {
+	case !errors.Is(err, causa) || (causa == nil) != (err == nil):
+		t.Errorf("%T(%v) de %T: erro = %v, esperado %v", d, v, v, err, causa)
+	case err == nil && obtido != valor && !(obtido != obtido && valor != valor): // NaN != NaN
+		t.Errorf("%T(%v) de %T = %v, esperado %v", d, v, v, obtido, valor)
+	case err == nil && !math.IsNaN(float64(obtido)) && O(obtido) != v:
+		t.Errorf("%T(%v) de %T = %v não volta ao valor original", d, v, v, obtido)
+	}
+}
+
+func conferirPara[D, O Numero](t *testing.T) {
+	for _, v := range fronteiras[O]() {
+		conferir[D](t, v)
+	}
+}
+
+func conferirDe[O Numero](t *testing.T) {
+	conferirPara[int, O](t)
+	conferirPara[int8, O](t)
+	conferirPara[int16, O](t)
+	conferirPara[int32, O](t)
+	conferirPara[int64, O](t)
+	conferirPara[uint, O](t)
+	conferirPara[uint8, O](t)
+	conferirPara[uint16, O](t)
+	conferirPara[uint32, O](t)
+	conferirPara[uint64, O](t)
+	conferirPara[float32, O](t)
+	conferirPara[float64, O](t)
+}
+
+// TestFronteiras converte os valores de fronteira de cada tipo para todos os
+// outros e confere o resultado com math/big.
+func TestFronteiras(t *testing.T) {
+	t.Run("int", conferirDe[int])
+	t.Run("int8", conferirDe[int8])
+	t.Run("int16", conferirDe[int16])
+	t.Run("int32", conferirDe[int32])
+	t.Run("int64", conferirDe[int64])
+	t.Run("uint", conferirDe[uint])
+	t.Run("uint8", conferirDe[uint8])
+	t.Run("uint16", conferirDe[uint16])
+	t.Run("uint32", conferirDe[uint32])
+	t.Run("uint64", conferirDe[uint64])
+	t.Run("float32", conferirDe[float32])
+	t.Run("float64", conferirDe[float64])
+}
+
+func FuzzFloat64ParaInteiros(f *testing.F) {
+	for _, v := range fronteiras[float64]() {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, v float64) {
+		conferir[int8](t, v)
+		conferir[uint8](t, v)
+		conferir[int32](t, v)
+		conferir[int64](t, v)
+		conferir[uint64](t, v)
+		conferir[float32](t, v)
+	})
+}
+
+func FuzzInt64(f *testing.F) {
+	for _, v := range fronteiras[int64]() {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, v int64) {
+		conferir[int8](t, v)
+		conferir[uint16](t, v)
+		conferir[int32](t, v)
+		conferir[uint64](t, v)
+		conferir[float32](t, v)
+		conferir[float64](t, v)
+	})
+}
+
+func FuzzUint64(f *testing.F) {
+	for _, v := range fronteiras[uint64]() {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, v uint64) {
+		conferir[int8](t, v)
+		conferir[int64](t, v)
+		conferir[uint32](t, v)
+		conferir[float32](t, v)
+		conferir[float64](t, v)
+	})
+}
+
+func FuzzFloat32(f *testing.F) {
+	for _, v := range fronteiras[float32]() {
+		f.Add(v)
+	}
+	f.Fuzz(func(t *testing.T, v float32) {
+		conferir[int16](t, v)
+		conferir[uint32](t, v)
+		conferir[int64](t, v)
+		conferir[float64](t, v)
+	})
+}
@@ -0,0 +1,171 @@
+// Package decoradores embrulha funções em outras com o mesmo formato,
+// acrescentando um comportamento antes ou depois da chamada: tentar de novo,
+// limitar o tempo, registrar, guardar resultados ou parar de chamar um
+// serviço que está falhando. Cada decorador é um closure: a função original e
+// o estado do decorador (contadores, cache, horário da última falha) ficam
+// capturados na função que ele devolve.
+package decoradores
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"modulo/idioma"
+	"modulo/relogio"
+)
+
+// Funcao é o formato que os decoradores recebem e devolvem: recebe um
+// contexto, para poder ser cancelada, e uma entrada, e devolve um resultado
+// ou um erro.
+type Funcao[T, R any] func(ctx context.Context, entrada T) (R, error)
+
+// Decorador transforma uma Funcao em outra com o mesmo formato.
+type Decorador[T, R any] func(Funcao[T, R]) Funcao[T, R]
+
+// Compor aplica os decoradores a f. O primeiro fica por fora: numa chamada,
+// ele roda antes dos outros e termina depois deles.
+//
+//	Compor(f, Registrar(...), Retentar(...)) == Registrar(...)(Retentar(...)(f))
+func Compor[T, R any](f Funcao[T, R], decoradores ...Decorador[T, R]) Funcao[T, R] {
+	for i := len(decoradores) - 1; i >= 0; i-- {
+		f = decoradores[i](f)
+	}
+	return f
+}
+
+// Backoff define as esperas entre as tentativas de Retentar: a primeira é
+// Inicial e cada uma das seguintes é Fator vezes a anterior, sem passar de
+// Maximo (zero: sem limite). Relogio nil usa relogio.Atual().
+type Backoff struct {
+	Inicial time.Duration
+	Maximo  time.Duration
+	Fator   float64
+	Relogio relogio.Relogio
+}
+
+// Retentar chama f até tentativas vezes, esperando entre uma e outra segundo
+// o backoff. Desiste antes se o contexto de quem chamou for cancelado,
+// inclusive no meio de uma espera. Um tempo esgotado dentro de f, como o de
+// LimitarTempo, vale só para aquela tentativa e é repetido. Nos outros casos
+// o erro devolvido é o da última tentativa.
+func Retentar[T, R any](tentativas int, backoff Backoff) Decorador[T, R] {
+	return func(f Funcao[T, R]) Funcao[T, R] {
+		return func(ctx context.Context, entrada T) (r R, err error) {
+			espera := backoff.Inicial
+			for tentativa := 1; ; tentativa++ {
+				r, err = f(ctx, entrada)
+				if err == nil || tentativa >= tentativas || ctx.Err() != nil {
+					return r, err
+				}
+				if errEspera := relogio.DormirOuCancelar(ctx, relogioDe(backoff.Relogio), espera); errEspera != nil {
+					var zero R
+					return zero, errEspera
+				}
+				espera = time.Duration(float64(espera) * backoff.Fator)
+				if backoff.Maximo > 0 && espera > backoff.Maximo {
+					espera = backoff.Maximo
+				}
+			}
+		}
+	}
+}
+
+// ErrTempoEsgotado é devolvido por LimitarTempo junto com
+// context.DeadlineExceeded; errors.Is funciona com os dois.
+var ErrTempoEsgotado = errors.New("tempo esgotado")
+
+// LimitarTempo cancela o contexto de f depois de limite e devolve
+// ErrTempoEsgotado se f não terminar antes. f roda numa goroutine e deve
+// observar ctx.Done() para parar de trabalhar; o resultado que ela produzir
+// depois do limite é descartado. O limite usa o tempo real, como o pacote
+// context.
+func LimitarTempo[T, R any](limite time.Duration) Decorador[T, R] {
+	type resposta struct {
+		r   R
+		err error
+	}
+	return func(f Funcao[T, R]) Funcao[T, R] {
+		return func(ctx context.Context, entrada T) (R, error) {
+			ctx, cancelar := context.WithTimeout(ctx, limite)
+			defer cancelar()
+
+			// com espaço para uma resposta, a goroutine termina mesmo que
+			// ninguém mais esteja esperando por ela
+			pronto := make(chan resposta, 1)
+			go func() {
+				r, err := f(ctx, entrada)
+				pronto <- resposta{r, err}
+			}()
+			select {
+			case resp := <-pronto:
+				return resp.r, resp.err
+			case <-ctx.Done():
+				var zero R
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					return zero, fmt.Errorf("%w (%v): %w", ErrTempoEsgotado, limite, ctx.Err())
+				}
+				return zero, ctx.Err()
+			}
+		}
+	}
+}
+
+// Registrar escreve em w uma linha quando f começa e outra quando termina,
+// com o resultado ou o erro e quanto tempo levou, medido com rel (nil usa
+// relogio.Atual()).
+func Registrar[T, R any](nome string, w io.Writer, rel relogio.Relogio) Decorador[T, R] {
+	return func(f Funcao[T, R]) Funcao[T, R] {
+		return func(ctx context.Context, entrada T) (r R, err error) {
+			inicio := relogioDe(rel).Agora()
+			fmt.Fprintf(w, "→ %s(%v)\n", nome, entrada)
+			// o defer roda depois do return e enxerga os resultados nomeados
+			defer func() {
+				duracao := relogioDe(rel).Agora().Sub(inicio)
+				if err != nil {
+					fmt.Fprintf(w, idioma.T("← %s(%v): erro: %v (%v)\n"), nome, entrada, err, duracao)
+					return
+				}
+				fmt.Fprintf(w, "← %s(%v) = %v (%v)\n", nome, entrada, r, duracao)
+			}()
+			return f(ctx, entrada)
+		}
+	}
+}
+
+// Memorizar guarda o resultado de cada entrada que deu certo e o devolve nas
+// próximas chamadas sem chamar f. Erros não são guardados. Cada chamada de
+// Memorizar cria um cache novo, compartilhado pelas funções que ele decorar.
+func Memorizar[T comparable, R any]() Decorador[T, R] {
+	var mu sync.Mutex
+	cache := make(map[T]R)
+	return func(f Funcao[T, R]) Funcao[T, R] {
+		return func(ctx context.Context, entrada T) (R, error) {
+			mu.Lock()
+			r, ok := cache[entrada]
+			mu.Unlock()
+			if ok {
+				return r, nil
+			}
+			// f roda sem a trava: duas chamadas simultâneas com a mesma
+			// entrada podem calcular o valor duas vezes
+			r, err := f(ctx, entrada)
+			if err == nil {
+				mu.Lock()
+				cache[entrada] = r
+				mu.Unlock()
+			}
+			return r, err
+		}
+	}
+}
+
+func relogioDe(r relogio.Relogio) relogio.Relogio {
+	if r == nil {
+		return relogio.Atual()
+	}
+	return r
+}
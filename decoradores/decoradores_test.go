@@ -0,0 +1,345 @@
+package decoradores
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"modulo/relogio"
+)
+
+var errFalhou = errors.New("falhou")
+
+// falhaAte devolve uma função que falha nas primeiras n chamadas e conta
+// quantas vezes foi chamada.
+func falhaAte(n int) (Funcao[int, int], *int) {
+	chamadas := 0
+	return func(_ context.Context, x int) (int, error) {
+		chamadas++
+		if chamadas <= n {
+			return 0, errFalhou
+		}
+		return x * 2, nil
+	}, &chamadas
+}
+
+func TestCompor(t *testing.T) {
+	var ordem []string
+	marcar := func(nome string) Decorador[int, int] {
+		return func(f Funcao[int, int]) Funcao[int, int] {
+			return func(ctx context.Context, x int) (int, error) {
+				ordem = append(ordem, "antes "+nome)
+				defer func() { ordem = append(ordem, "depois "+nome) }()
+				return f(ctx, x)
+			}
+		}
+	}
+	f := Compor(func(_ context.Context, x int) (int, error) {
+		ordem = append(ordem, "f")
+		return x, nil
+	}, marcar("a"), marcar("b"))
+	f(context.Background(), 1)
+	esperado := []string{"antes a", "antes b", "f", "depois b", "depois a"}
+	if !reflect.DeepEqual(ordem, esperado) {
+		t.Errorf("ordem = %q, esperado %q", ordem, esperado)
+	}
+}
+
+func TestRetentar(t *testing.T) {
+	falso := relogio.NovoFalso(time.Time{})
+	backoff := Backoff{Inicial: 100 * time.Millisecond, Maximo: 300 * time.Millisecond, Fator: 2, Relogio: falso}
+
+	f, chamadas := falhaAte(3)
+	r, err := Retentar[int, int](5, backoff)(f)(context.Background(), 21)
+	if err != nil || r != 42 || *chamadas != 4 {
+		t.Errorf("Retentar = %d, %v depois de %d chamadas; esperado 42, nil, 4", r, err, *chamadas)
+	}
+	esperado := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond}
+	if obtido := falso.Esperas(); !reflect.DeepEqual(obtido, esperado) {
+		t.Errorf("esperas = %v, esperado %v", obtido, esperado)
+	}
+
+	f, chamadas = falhaAte(10)
+	if _, err := Retentar[int, int](3, backoff)(f)(context.Background(), 1); !errors.Is(err, errFalhou) || *chamadas != 3 {
+		t.Errorf("Retentar(3) = %v depois de %d chamadas; esperado errFalhou e 3", err, *chamadas)
+	}
+}
+
+func TestRetentarNaoRepeteContexto(t *testing.T) {
+	chamadas := 0
+	f := func(ctx context.Context, _ int) (int, error) {
+		chamadas++
+		return 0, ctx.Err()
+	}
+	ctx, cancelar := context.WithCancel(context.Background())
+	cancelar()
+	backoff := Backoff{Inicial: time.Second, Fator: 2, Relogio: relogio.NovoFalso(time.Time{})}
+	if _, err := Retentar[int, int](5, backoff)(f)(ctx, 1); !errors.Is(err, context.Canceled) || chamadas != 1 {
+		t.Errorf("Retentar com contexto cancelado = %v depois de %d chamadas; esperado Canceled e 1", err, chamadas)
+	}
+}
+
+func TestRetentarRepeteTempoEsgotado(t *testing.T) {
+	// a primeira chamada passa do limite; o tempo esgotado é só daquela
+	// tentativa, e Retentar tenta de novo
+	// f roda numa goroutine de LimitarTempo a cada tentativa
+	var chamadas atomic.Int32
+	f := func(ctx context.Context, x int) (int, error) {
+		if chamadas.Add(1) == 1 {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		return x * 2, nil
+	}
+	backoff := Backoff{Inicial: time.Millisecond, Fator: 2, Relogio: relogio.NovoFalso(time.Time{})}
+	g := Compor(f, Retentar[int, int](3, backoff), LimitarTempo[int, int](20*time.Millisecond))
+	if r, err := g(context.Background(), 21); err != nil || r != 42 || chamadas.Load() != 2 {
+		t.Errorf("Retentar sobre LimitarTempo = %d, %v depois de %d chamadas; esperado 42, nil e 2", r, err, chamadas.Load())
+	}
+}
+
+func TestRetentarCancelaNaEspera(t *testing.T) {
+	f, chamadas := falhaAte(10)
+	// com o relógio real e uma espera de uma hora, o teste só termina logo
+	// se o cancelamento interromper a espera
+	backoff := Backoff{Inicial: time.Hour, Fator: 2, Relogio: relogio.Real{}}
+	ctx, cancelar := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelar()
+
+	inicio := time.Now()
+	r, err := Retentar[int, int](5, backoff)(f)(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) || r != 0 || *chamadas != 1 {
+		t.Errorf("Retentar cancelado na espera = %d, %v depois de %d chamadas; esperado 0, DeadlineExceeded e 1", r, err, *chamadas)
+	}
+	if d := time.Since(inicio); d > time.Second {
+		t.Errorf("Retentar levou %v: a espera não foi interrompida", d)
+	}
+}
+
+func TestLimitarTempo(t *testing.T) {
+	lenta := func(ctx context.Context, x int) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	_, err := LimitarTempo[int, int](10*time.Millisecond)(lenta)(context.Background(), 1)
+	if !errors.Is(err, ErrTempoEsgotado) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("LimitarTempo(lenta) = %v, esperado ErrTempoEsgotado e DeadlineExceeded", err)
+	}
+
+	rapida := func(_ context.Context, x int) (int, error) { return x + 1, nil }
+	if r, err := LimitarTempo[int, int](time.Second)(rapida)(context.Background(), 1); r != 2 || err != nil {
+		t.Errorf("LimitarTempo(rapida) = %d, %v; esperado 2, nil", r, err)
+	}
+
+	// cancelar o contexto de fora não é tempo esgotado
+	ctx, cancelar := context.WithCancel(context.Background())
+	cancelar()
+	if _, err := LimitarTempo[int, int](time.Second)(lenta)(ctx, 1); errors.Is(err, ErrTempoEsgotado) || !errors.Is(err, context.Canceled) {
+		t.Errorf("LimitarTempo com contexto cancelado = %v, esperado só Canceled", err)
+	}
+}
+
+func TestRegistrar(t *testing.T) {
+	falso := relogio.NovoFalso(time.Time{})
+	var saida bytes.Buffer
+	f := func(_ context.Context, x int) (int, error) {
+		falso.Dormir(50 * time.Millisecond)
+		if x < 0 {
+			return 0, errFalhou
+		}
+		return x * x, nil
+	}
+	registrada := Registrar[int, int]("quadrado", &saida, falso)(f)
+	registrada(context.Background(), 3)
+	registrada(context.Background(), -1)
+	esperado := "→ quadrado(3)\n← quadrado(3) = 9 (50ms)\n→ quadrado(-1)\n← quadrado(-1): erro: falhou (50ms)\n"
+	if saida.String() != esperado {
+		t.Errorf("saída:\n%s\nwant:\n%s", saida.String(), esperado)
+	}
+}
+
+func TestMemorizar(t *testing.T) {
+	chamadas := map[int]int{}
+	f := func(_ context.Context, x int) (string, error) {
+		chamadas[x]++
+		if x < 0 {
+			return "", errFalhou
+		}
+		return fmt.Sprint(x), nil
+	}
+	m := Memorizar[int, string]()(f)
+	for range 3 {
+		m(context.Background(), 7)
+		m(context.Background(), -1)
+	}
+	if chamadas[7] != 1 {
+		t.Errorf("f(7) chamada %d vezes, esperado 1", chamadas[7])
+	}
+	if chamadas[-1] != 3 {
+		t.Errorf("f(-1) chamada %d vezes, esperado 3: erros não ficam no cache", chamadas[-1])
+	}
+}
+
+func TestMemorizarConcorrente(t *testing.T) {
+	m := Memorizar[int, int]()(func(_ context.Context, x int) (int, error) { return x * x, nil })
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if r, _ := m(context.Background(), i%5); r != (i%5)*(i%5) {
+				t.Errorf("m(%d) = %d", i%5, r)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDisjuntor(t *testing.T) {
+	falso := relogio.NovoFalso(time.Time{})
+	d := &Disjuntor{FalhasMaximas: 3, Pausa: time.Minute, Relogio: falso}
+	chamadas := 0
+	falhar := true
+	f := ComDisjuntor[int, int](d)(func(_ context.Context, x int) (int, error) {
+		chamadas++
+		if falhar {
+			return 0, errFalhou
+		}
+		return x, nil
+	})
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := f(ctx, 1); !errors.Is(err, errFalhou) {
+			t.Fatalf("chamada com o disjuntor fechado = %v, esperado errFalhou", err)
+		}
+	}
+	if d.Estado() != Aberto {
+		t.Fatalf("depois de 3 falhas o estado é %v, esperado aberto", d.Estado())
+	}
+	if _, err := f(ctx, 1); !errors.Is(err, ErrCircuitoAberto) || chamadas != 3 {
+		t.Errorf("chamada com o disjuntor aberto = %v com %d chamadas, esperado ErrCircuitoAberto sem chamar", err, chamadas)
+	}
+
+	falso.Avancar(time.Minute)
+	if d.Estado() != MeioAberto {
+		t.Fatalf("depois da pausa o estado é %v, esperado meio aberto", d.Estado())
+	}
+	// a chamada de teste falha: abre de novo por mais uma pausa
+	if _, err := f(ctx, 1); !errors.Is(err, errFalhou) || d.Estado() != Aberto {
+		t.Errorf("teste que falha = %v, estado %v; esperado errFalhou e aberto", err, d.Estado())
+	}
+
+	falso.Avancar(time.Minute)
+	falhar = false
+	if r, err := f(ctx, 5); r != 5 || err != nil || d.Estado() != Fechado {
+		t.Errorf("teste que dá certo = %d, %v, estado %v; esperado 5, nil e fechado", r, err, d.Estado())
+	}
+	// fechado de novo, a contagem recomeça
+	falhar = true
+	f(ctx, 1)
+	f(ctx, 1)
+	if d.Estado() != Fechado {
+		t.Errorf("2 falhas depois de fechar: estado %v, esperado fechado", d.Estado())
+	}
+}
+
+func TestDisjuntorTesteCancelado(t *testing.T) {
+	falso := relogio.NovoFalso(time.Time{})
+	d := &Disjuntor{FalhasMaximas: 2, Pausa: time.Minute, Relogio: falso}
+	chamadas := 0
+	f := ComDisjuntor[int, int](d)(func(ctx context.Context, x int) (int, error) {
+		chamadas++
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		if x < 0 {
+			return 0, errFalhou
+		}
+		return x, nil
+	})
+	f(context.Background(), -1)
+	f(context.Background(), -1)
+	falso.Avancar(time.Minute)
+
+	// a chamada de teste é cancelada: não diz nada sobre a função
+	cancelado, cancelar := context.WithCancel(context.Background())
+	cancelar()
+	if _, err := f(cancelado, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("teste cancelado = %v, esperado Canceled", err)
+	}
+	if d.Estado() != MeioAberto {
+		t.Fatalf("depois do teste cancelado o estado é %v, esperado meio aberto", d.Estado())
+	}
+	// sem esperar outra pausa, a próxima chamada é o novo teste
+	if r, err := f(context.Background(), 5); r != 5 || err != nil || d.Estado() != Fechado || chamadas != 4 {
+		t.Errorf("novo teste = %d, %v, estado %v, %d chamadas; esperado 5, nil, fechado e 4", r, err, d.Estado(), chamadas)
+	}
+}
+
+func TestDisjuntorTesteDemorado(t *testing.T) {
+	falso := relogio.NovoFalso(time.Time{})
+	d := &Disjuntor{FalhasMaximas: 1, Pausa: time.Minute, Relogio: falso}
+	var chamadas atomic.Int32
+	comecou, liberar := make(chan struct{}), make(chan struct{})
+	f := ComDisjuntor[int, int](d)(func(_ context.Context, x int) (int, error) {
+		chamadas.Add(1)
+		if x < 0 {
+			return 0, errFalhou
+		}
+		close(comecou)
+		<-liberar
+		return x, nil
+	})
+	f(context.Background(), -1)
+	falso.Avancar(time.Minute)
+
+	// o teste passa de uma pausa inteira sem terminar
+	pronto := make(chan error)
+	go func() {
+		_, err := f(context.Background(), 5)
+		pronto <- err
+	}()
+	<-comecou
+	falso.Avancar(2 * time.Minute)
+	if _, err := f(context.Background(), 7); !errors.Is(err, ErrCircuitoAberto) || chamadas.Load() != 2 {
+		t.Errorf("chamada durante o teste = %v com %d chamadas, esperado ErrCircuitoAberto sem chamar", err, chamadas.Load())
+	}
+
+	close(liberar)
+	if err := <-pronto; err != nil || d.Estado() != Fechado {
+		t.Errorf("teste demorado = %v, estado %v; esperado nil e fechado", err, d.Estado())
+	}
+}
+
+func TestDecoradoresCompostos(t *testing.T) {
+	falso := relogio.NovoFalso(time.Time{})
+	var saida bytes.Buffer
+	f, chamadas := falhaAte(1)
+	composta := Compor(f,
+		Registrar[int, int]("dobro", &saida, falso),
+		Memorizar[int, int](),
+		Retentar[int, int](3, Backoff{Inicial: time.Second, Fator: 2, Relogio: falso}),
+	)
+	for range 2 {
+		if r, err := composta(context.Background(), 4); r != 8 || err != nil {
+			t.Fatalf("composta(4) = %d, %v", r, err)
+		}
+	}
+	if *chamadas != 2 {
+		t.Errorf("f chamada %d vezes, esperado 2 (uma falha, uma retentativa, depois o cache)", *chamadas)
+	}
+	if obtido := strings.Count(saida.String(), "← dobro(4) = 8"); obtido != 2 {
+		t.Errorf("saída:\n%s\nwant duas chamadas registradas", saida.String())
+	}
+	if !strings.Contains(saida.String(), "= 8 (1s)") {
+		t.Errorf("saída:\n%s\nwant a primeira chamada levando a espera de 1s", saida.String())
+	}
+}
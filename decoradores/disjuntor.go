@@ -0,0 +1,144 @@
+package decoradores
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"modulo/idioma"
+	"modulo/relogio"
+)
+
+// ErrCircuitoAberto é devolvido, sem chamar a função, enquanto o Disjuntor
+// está aberto.
+var ErrCircuitoAberto = errors.New("circuito aberto")
+
+// Estado é a situação de um Disjuntor.
+type Estado int
+
+const (
+	// Fechado: as chamadas passam normalmente.
+	Fechado Estado = iota
+	// Aberto: as chamadas falham na hora com ErrCircuitoAberto.
+	Aberto
+	// MeioAberto: passada a pausa, uma chamada de teste decide se o
+	// disjuntor fecha (deu certo) ou abre de novo (falhou).
+	MeioAberto
+)
+
+func (e Estado) String() string {
+	switch e {
+	case Fechado:
+		return idioma.T("fechado")
+	case Aberto:
+		return idioma.T("aberto")
+	}
+	return idioma.T("meio aberto")
+}
+
+// Disjuntor (circuit breaker) para de chamar uma função que falhou
+// FalhasMaximas vezes seguidas, como o disjuntor de uma casa desarma depois
+// de um curto. Depois de Pausa ele deixa uma chamada passar para testar se o
+// problema acabou. Relogio nil usa relogio.Atual().
+//
+// O mesmo Disjuntor pode proteger várias funções, que passam a abrir e
+// fechar juntas; use ComDisjuntor para decorar cada uma.
+type Disjuntor struct {
+	FalhasMaximas int
+	Pausa         time.Duration
+	Relogio       relogio.Relogio
+
+	mu       sync.Mutex
+	estado   Estado
+	falhas   int
+	abertoEm time.Time
+	testando bool // a chamada de teste está em andamento
+}
+
+// Estado devolve a situação atual, já contando a pausa: um disjuntor aberto
+// há mais de Pausa está meio aberto.
+func (d *Disjuntor) Estado() Estado {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.atualizar()
+}
+
+// atualizar passa de Aberto para MeioAberto quando a pausa termina. Precisa
+// ser chamada com d.mu travado.
+func (d *Disjuntor) atualizar() Estado {
+	if d.estado == Aberto && relogioDe(d.Relogio).Agora().Sub(d.abertoEm) >= d.Pausa {
+		d.estado = MeioAberto
+	}
+	return d.estado
+}
+
+// permitir diz se uma chamada pode passar e se ela é a chamada de teste. No
+// estado MeioAberto só a primeira passa; as outras, enquanto ela não termina,
+// falham na hora, por mais que demore.
+func (d *Disjuntor) permitir() (ok, teste bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch d.atualizar() {
+	case Aberto:
+		return false, false
+	case MeioAberto:
+		if d.testando {
+			return false, false
+		}
+		d.testando = true
+		return true, true
+	}
+	return true, false
+}
+
+// liberarTeste deixa o disjuntor em MeioAberto, sem teste em andamento,
+// quando a chamada de teste é cancelada: ela não disse nada sobre a função, e
+// a próxima chamada testa.
+func (d *Disjuntor) liberarTeste() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.testando = false
+}
+
+// registrar conta o resultado de uma chamada que passou. Se a chamada de
+// teste falha, o disjuntor abre de novo sem esperar FalhasMaximas.
+func (d *Disjuntor) registrar(err error, teste bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if teste {
+		d.testando = false
+	}
+	if err == nil {
+		d.estado, d.falhas = Fechado, 0
+		return
+	}
+	d.falhas++
+	if teste || d.falhas >= d.FalhasMaximas {
+		d.estado = Aberto
+		d.abertoEm = relogioDe(d.Relogio).Agora()
+	}
+}
+
+// ComDisjuntor decora f para passar pelo disjuntor d. Uma chamada cancelada
+// pelo contexto não conta nem como falha nem como sucesso; se era a chamada
+// de teste, o disjuntor volta a MeioAberto.
+func ComDisjuntor[T, R any](d *Disjuntor) Decorador[T, R] {
+	return func(f Funcao[T, R]) Funcao[T, R] {
+		return func(ctx context.Context, entrada T) (R, error) {
+			ok, teste := d.permitir()
+			if !ok {
+				var zero R
+				return zero, ErrCircuitoAberto
+			}
+			r, err := f(ctx, entrada)
+			switch {
+			case !errors.Is(err, context.Canceled):
+				d.registrar(err, teste)
+			case teste:
+				d.liberarTeste()
+			}
+			return r, err
+		}
+	}
+}
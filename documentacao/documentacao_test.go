@@ -0,0 +1,87 @@
+package documentacao
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func extrairExemplo(t *testing.T) []Trecho {
+	t.Helper()
+	arquivo := filepath.Join("testdata", "exemplo.md")
+	conteudo, err := os.ReadFile(arquivo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Extrair(arquivo, conteudo)
+}
+
+func TestExtrair(t *testing.T) {
+	trechos := extrairExemplo(t)
+	if len(trechos) != 5 {
+		t.Fatalf("esperava 5 trechos go, obtido %d", len(trechos))
+	}
+
+	primeiro := trechos[0]
+	if primeiro.Linha != 6 || !primeiro.TemSaida || primeiro.Saida != "3\n" || primeiro.LinhaSaida != 13 {
+		t.Errorf("primeiro trecho = %+v", primeiro)
+	}
+	if trechos[2].TemSaida {
+		t.Error("o trecho que não compila não tem bloco de saída")
+	}
+	if !trechos[3].Ignorar || !trechos[4].SoCompilar || trechos[4].Ignorar {
+		t.Errorf("diretivas não reconhecidas: %+v %+v", trechos[3], trechos[4])
+	}
+}
+
+func TestMontar(t *testing.T) {
+	programa, err := Montar("type Pessoa struct {\n\tNome string\n}\n\np := Pessoa{}\nfmt.Println(p)\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, trecho := range []string{"package main", `import "fmt"`, "type Pessoa struct {", "func main() {", "p := Pessoa{}", "\t_ = p\n}"} {
+		if !strings.Contains(programa.Codigo, trecho) {
+			t.Errorf("programa não contém %q:\n%s", trecho, programa.Codigo)
+		}
+	}
+
+	// A linha de "fmt.Println(p)" no programa aponta para a linha 5 (índice) do trecho.
+	for i, linha := range strings.Split(programa.Codigo, "\n") {
+		if linha == "fmt.Println(p)" && programa.LinhaDoTrecho(i+1) != 5 {
+			t.Errorf("LinhaDoTrecho(%d) = %d, esperado 5", i+1, programa.LinhaDoTrecho(i+1))
+		}
+	}
+
+	if _, err := Montar("func main() {}\nfmt.Println(1)\n"); err == nil {
+		t.Error("esperava erro para func main junto com instruções soltas")
+	}
+}
+
+func TestVerificar(t *testing.T) {
+	resultados, err := Verificar(extrairExemplo(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	esperado := []struct {
+		situacao Situacao
+		linha    int
+		mensagem string
+	}{
+		{Passou, 6, ""},
+		{SaidaDiferente, 23, "obtido:\nGO"},
+		{ErroCompilacao, 34, "p.Idade undefined"},
+		{Ignorado, 39, ""},
+		{Passou, 44, ""},
+	}
+	for i, e := range esperado {
+		r := resultados[i]
+		if r.Situacao != e.situacao || r.Linha != e.linha || !strings.Contains(r.Mensagem, e.mensagem) {
+			t.Errorf("trecho %d: obtido %v (linha %d, %q), esperado %v (linha %d, %q)", i, r.Situacao, r.Linha, r.Mensagem, e.situacao, e.linha, e.mensagem)
+		}
+	}
+	if !strings.HasPrefix(resultados[2].String(), filepath.Join("testdata", "exemplo.md")+":34: erro de compilação") {
+		t.Errorf("formato arquivo:linha inesperado: %s", resultados[2])
+	}
+}
@@ -0,0 +1,265 @@
+package documentacao
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Programa é o código Go completo gerado a partir de um trecho.
+// Origem[i] é a linha do trecho (a partir de 0) que gerou a linha i+1 do
+// programa, ou -1 quando a linha foi criada pelo Montar.
+type Programa struct {
+	Codigo string
+	Origem []int
+}
+
+// LinhaDoTrecho converte uma linha do programa (a partir de 1) na linha
+// correspondente do trecho, ou -1 quando ela não veio do trecho.
+func (p Programa) LinhaDoTrecho(linha int) int {
+	if linha < 1 || linha > len(p.Origem) {
+		return -1
+	}
+	return p.Origem[linha-1]
+}
+
+// pacotesConhecidos são os pacotes importados automaticamente quando o
+// trecho usa nome.Algo sem declarar o import.
+var pacotesConhecidos = map[string]string{
+	"bufio":    "bufio",
+	"bytes":    "bytes",
+	"context":  "context",
+	"errors":   "errors",
+	"filepath": "path/filepath",
+	"fmt":      "fmt",
+	"io":       "io",
+	"json":     "encoding/json",
+	"log":      "log",
+	"math":     "math",
+	"os":       "os",
+	"rand":     "math/rand",
+	"reflect":  "reflect",
+	"sort":     "sort",
+	"strconv":  "strconv",
+	"strings":  "strings",
+	"sync":     "sync",
+	"time":     "time",
+	"unicode":  "unicode",
+	"utf8":     "unicode/utf8",
+}
+
+var (
+	clausulaPackage  = regexp.MustCompile(`(?m)^package\s+(\w+)`)
+	funcMain         = regexp.MustCompile(`(?m)^func main\(\)`)
+	caminhoImport    = regexp.MustCompile(`"([\w/]+)"`)
+	usoDePacote      = regexp.MustCompile(`\b([a-z][a-z0-9]*)\.[A-Za-z_]`)
+	inicioDeclaracao = regexp.MustCompile(`^(func|type|import)\b`)
+)
+
+type linha struct {
+	texto  string
+	origem int
+}
+
+// Montar transforma um trecho em um programa executável:
+//   - trechos com "package" são usados como estão (o pacote vira main);
+//   - declarações de func, type e import no início da linha vão para o nível do pacote;
+//   - o resto vira o corpo de func main;
+//   - imports usados mas não declarados são adicionados;
+//   - variáveis declaradas em main recebem "_ = nome" para não dar erro de "declared and not used".
+func Montar(codigo string) (Programa, error) {
+	linhas := strings.Split(strings.TrimRight(codigo, "\n"), "\n")
+
+	if m := clausulaPackage.FindStringSubmatchIndex(codigo); m != nil {
+		completo := codigo[:m[2]] + "main" + codigo[m[3]:]
+		origem := make([]int, len(linhas))
+		for i := range origem {
+			origem[i] = i
+		}
+		if !funcMain.MatchString(completo) {
+			completo += "\nfunc main() {}\n"
+			origem = append(origem, -1, -1)
+		}
+		return Programa{Codigo: completo, Origem: origem}, nil
+	}
+
+	declaracoes, instrucoes := separar(linhas)
+	temMain := funcMain.MatchString(juntar(declaracoes))
+	if temMain && strings.TrimSpace(juntar(instrucoes)) != "" {
+		return Programa{}, fmt.Errorf("o trecho tem func main e também instruções fora de funções")
+	}
+
+	declarados := map[string]bool{}
+	for _, m := range caminhoImport.FindAllStringSubmatch(juntarImports(declaracoes), -1) {
+		partes := strings.Split(m[1], "/")
+		declarados[partes[len(partes)-1]] = true
+	}
+
+	var usados []string
+	for _, m := range usoDePacote.FindAllStringSubmatch(semStrings(codigo), -1) {
+		caminho, ok := pacotesConhecidos[m[1]]
+		if ok && !declarados[m[1]] && !strings.Contains(codigo, m[1]+" :=") && !strings.Contains(codigo, "var "+m[1]+" ") {
+			declarados[m[1]] = true
+			usados = append(usados, caminho)
+		}
+	}
+	sort.Strings(usados)
+
+	var programa []linha
+	gerar := func(texto string) { programa = append(programa, linha{texto, -1}) }
+	gerar("package main")
+	gerar("")
+	for _, caminho := range usados {
+		gerar(fmt.Sprintf("import %q", caminho))
+	}
+	if len(usados) > 0 {
+		gerar("")
+	}
+	programa = append(programa, declaracoes...)
+	gerar("")
+	if !temMain {
+		gerar("func main() {")
+		programa = append(programa, instrucoes...)
+		for _, nome := range variaveisDeclaradas(instrucoes) {
+			gerar("\t_ = " + nome)
+		}
+		gerar("}")
+	}
+
+	var codigoFinal strings.Builder
+	origem := make([]int, len(programa))
+	for i, l := range programa {
+		codigoFinal.WriteString(l.texto)
+		codigoFinal.WriteString("\n")
+		origem[i] = l.origem
+	}
+	return Programa{Codigo: codigoFinal.String(), Origem: origem}, nil
+}
+
+// separar divide as linhas em declarações de nível de pacote e instruções.
+// Uma declaração começa na coluna 0 com func, type ou import e termina quando
+// as chaves e parênteses abertos nela são fechados. Comentários logo acima
+// de uma declaração vão junto com ela.
+func separar(linhas []string) (declaracoes, instrucoes []linha) {
+	var comentarios []linha
+	for i := 0; i < len(linhas); i++ {
+		texto := linhas[i]
+		if strings.HasPrefix(texto, "//") {
+			comentarios = append(comentarios, linha{texto, i})
+			continue
+		}
+		if !inicioDeclaracao.MatchString(texto) {
+			instrucoes = append(instrucoes, comentarios...)
+			comentarios = nil
+			instrucoes = append(instrucoes, linha{texto, i})
+			continue
+		}
+
+		declaracoes = append(declaracoes, comentarios...)
+		comentarios = nil
+		profundidade := 0
+		for ; i < len(linhas); i++ {
+			declaracoes = append(declaracoes, linha{linhas[i], i})
+			profundidade += abreFecha(linhas[i])
+			if profundidade <= 0 {
+				break
+			}
+		}
+	}
+	instrucoes = append(instrucoes, comentarios...)
+	return declaracoes, instrucoes
+}
+
+// abreFecha conta quantas chaves/parênteses a linha abre menos quantos fecha,
+// ignorando o que está dentro de strings e comentários.
+func abreFecha(texto string) int {
+	saldo := 0
+	for _, r := range semStrings(texto) {
+		switch r {
+		case '{', '(':
+			saldo++
+		case '}', ')':
+			saldo--
+		}
+	}
+	return saldo
+}
+
+var (
+	literalTexto = regexp.MustCompile("\"(\\\\.|[^\"\\\\])*\"|`[^`]*`|'(\\\\.|[^'\\\\])*'")
+	comentario   = regexp.MustCompile(`//.*`)
+)
+
+func semStrings(texto string) string {
+	return comentario.ReplaceAllString(literalTexto.ReplaceAllString(texto, `""`), "")
+}
+
+// juntarImports devolve só os blocos import das declarações.
+func juntarImports(declaracoes []linha) string {
+	var b strings.Builder
+	dentro := false
+	for _, l := range declaracoes {
+		if strings.HasPrefix(l.texto, "import") {
+			dentro = true
+		} else if inicioDeclaracao.MatchString(l.texto) {
+			dentro = false
+		}
+		if dentro {
+			b.WriteString(l.texto)
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func juntar(linhas []linha) string {
+	var b strings.Builder
+	for _, l := range linhas {
+		b.WriteString(l.texto)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// variaveisDeclaradas devolve as variáveis declaradas no nível mais externo
+// das instruções (com var ou :=). Se as instruções não forem Go válido,
+// devolve nil e o erro aparece na compilação.
+func variaveisDeclaradas(instrucoes []linha) []string {
+	arquivo, err := parser.ParseFile(token.NewFileSet(), "", "package p\nfunc f() {\n"+juntar(instrucoes)+"\n}", 0)
+	if err != nil {
+		return nil
+	}
+	vistas := map[string]bool{}
+	var nomes []string
+	adicionar := func(ident *ast.Ident) {
+		if ident.Name != "_" && !vistas[ident.Name] {
+			vistas[ident.Name] = true
+			nomes = append(nomes, ident.Name)
+		}
+	}
+	for _, instrucao := range arquivo.Decls[0].(*ast.FuncDecl).Body.List {
+		switch instrucao := instrucao.(type) {
+		case *ast.AssignStmt:
+			if instrucao.Tok == token.DEFINE {
+				for _, lado := range instrucao.Lhs {
+					if ident, ok := lado.(*ast.Ident); ok {
+						adicionar(ident)
+					}
+				}
+			}
+		case *ast.DeclStmt:
+			if gen, ok := instrucao.Decl.(*ast.GenDecl); ok && gen.Tok == token.VAR {
+				for _, spec := range gen.Specs {
+					for _, nome := range spec.(*ast.ValueSpec).Names {
+						adicionar(nome)
+					}
+				}
+			}
+		}
+	}
+	return nomes
+}
@@ -0,0 +1,106 @@
+package documentacao
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+)
+
+// Diretivas em comentário HTML (invisíveis no GitHub) colocadas nas linhas
+// antes de um bloco ```go mudam como o trecho é verificado.
+const (
+	// DiretivaIgnorar pula o trecho (pseudo-código, exemplos de erro, fragmentos).
+	DiretivaIgnorar = "<!-- docs:ignorar -->"
+	// DiretivaSoCompilar compila o trecho mas não executa (servidores, loops infinitos, panics).
+	DiretivaSoCompilar = "<!-- docs:so-compilar -->"
+)
+
+// Trecho é um bloco ```go de um arquivo markdown.
+// Linha é a linha (a partir de 1) da primeira linha de código do bloco.
+// Quando o bloco é seguido por um bloco ```output, Saida guarda o conteúdo
+// esperado e LinhaSaida a sua primeira linha.
+type Trecho struct {
+	Arquivo    string
+	Linha      int
+	Codigo     string
+	TemSaida   bool
+	Saida      string
+	LinhaSaida int
+	Ignorar    bool
+	SoCompilar bool
+}
+
+// Extrair devolve os trechos Go de um markdown, na ordem em que aparecem.
+func Extrair(arquivo string, conteudo []byte) []Trecho {
+	var (
+		trechos        []Trecho
+		diretivas      []string
+		atual          *Trecho
+		linguagem      string
+		corpo          strings.Builder
+		numero         int
+		esperandoSaida bool
+	)
+
+	leitor := bufio.NewScanner(bytes.NewReader(conteudo))
+	for leitor.Scan() {
+		numero++
+		linha := leitor.Text()
+		limpa := strings.TrimSpace(linha)
+
+		if atual == nil {
+			switch {
+			case strings.HasPrefix(limpa, "```"):
+				linguagem = ""
+				if campos := strings.Fields(strings.TrimPrefix(limpa, "```")); len(campos) > 0 {
+					linguagem = campos[0]
+				}
+				atual = &Trecho{Arquivo: arquivo, Linha: numero + 1}
+				corpo.Reset()
+			case strings.HasPrefix(limpa, "<!-- docs:"):
+				diretivas = append(diretivas, limpa)
+			case limpa == "" || titulo(limpa):
+				// Linhas vazias e títulos curtos como "**Saída:**" podem
+				// ficar entre as diretivas, o bloco Go e o bloco de saída.
+			default:
+				diretivas = nil
+				esperandoSaida = false
+			}
+			continue
+		}
+
+		if limpa != "```" {
+			corpo.WriteString(linha)
+			corpo.WriteString("\n")
+			continue
+		}
+
+		switch linguagem {
+		case "go":
+			atual.Codigo = corpo.String()
+			for _, diretiva := range diretivas {
+				atual.Ignorar = atual.Ignorar || diretiva == DiretivaIgnorar
+				atual.SoCompilar = atual.SoCompilar || diretiva == DiretivaSoCompilar
+			}
+			trechos = append(trechos, *atual)
+			esperandoSaida = true
+		case "output":
+			if esperandoSaida {
+				anterior := &trechos[len(trechos)-1]
+				anterior.TemSaida = true
+				anterior.Saida = corpo.String()
+				anterior.LinhaSaida = atual.Linha
+			}
+			esperandoSaida = false
+		default:
+			esperandoSaida = false
+		}
+		diretivas = nil
+		atual = nil
+	}
+	return trechos
+}
+
+func titulo(linha string) bool {
+	return strings.HasPrefix(linha, "**") && strings.HasSuffix(linha, "**") && len(linha) < 40
+}
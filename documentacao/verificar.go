@@ -0,0 +1,275 @@
+package documentacao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Situacao é o resultado da verificação de um trecho.
+type Situacao int
+
+const (
+	Passou Situacao = iota
+	Ignorado
+	ErroCompilacao
+	ErroExecucao
+	SaidaDiferente
+)
+
+func (s Situacao) String() string {
+	switch s {
+	case Passou:
+		return "ok"
+	case Ignorado:
+		return "ignorado"
+	case ErroCompilacao:
+		return "erro de compilação"
+	case ErroExecucao:
+		return "erro de execução"
+	case SaidaDiferente:
+		return "saída diferente"
+	}
+	return "situação desconhecida"
+}
+
+// Resultado diz o que aconteceu com um trecho. Linha é a linha do markdown
+// onde o problema foi encontrado (ou a primeira linha do trecho).
+type Resultado struct {
+	Trecho   Trecho
+	Situacao Situacao
+	Linha    int
+	Mensagem string
+}
+
+// Falhou indica se o trecho não compilou, falhou ao executar ou imprimiu outra saída.
+func (r Resultado) Falhou() bool {
+	return r.Situacao != Passou && r.Situacao != Ignorado
+}
+
+// String usa o formato arquivo:linha: para que editores e o terminal abram o local.
+func (r Resultado) String() string {
+	texto := fmt.Sprintf("%s:%d: %s", r.Trecho.Arquivo, r.Linha, r.Situacao)
+	if r.Mensagem != "" {
+		texto += ": " + r.Mensagem
+	}
+	return texto
+}
+
+// TempoLimiteExecucao é quanto cada trecho pode rodar antes de ser interrompido.
+var TempoLimiteExecucao = 10 * time.Second
+
+var erroDoCompilador = regexp.MustCompile(`^\.?/?(t\d+)/main\.go:(\d+)(?::\d+)?: (.*)$`)
+
+// Verificar monta um programa para cada trecho, compila todos de uma vez em
+// um módulo temporário, executa os que compilaram e compara com a saída esperada.
+func Verificar(trechos []Trecho) ([]Resultado, error) {
+	dir, err := os.MkdirTemp("", "docs-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module trechos\n\ngo 1.23\n"), 0o644); err != nil {
+		return nil, err
+	}
+
+	padrao, err := bibliotecaPadrao()
+	if err != nil {
+		return nil, err
+	}
+
+	resultados := make([]Resultado, len(trechos))
+	programas := make(map[string]Programa)
+	indices := make(map[string]int)
+	for i, trecho := range trechos {
+		resultados[i] = Resultado{Trecho: trecho, Linha: trecho.Linha}
+		if trecho.Ignorar {
+			resultados[i].Situacao = Ignorado
+			continue
+		}
+		programa, err := Montar(trecho.Codigo)
+		if err != nil {
+			resultados[i].Situacao = ErroCompilacao
+			resultados[i].Mensagem = err.Error()
+			continue
+		}
+		if linha, caminho := importForaDaBiblioteca(programa, padrao); caminho != "" {
+			resultados[i].Situacao = ErroCompilacao
+			resultados[i].Mensagem = fmt.Sprintf("o pacote %q não é da biblioteca padrão", caminho)
+			if origem := programa.LinhaDoTrecho(linha); origem >= 0 {
+				resultados[i].Linha = trecho.Linha + origem
+			}
+			continue
+		}
+
+		pacote := fmt.Sprintf("t%04d", i)
+		if err := os.Mkdir(filepath.Join(dir, pacote), 0o755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(filepath.Join(dir, pacote, "main.go"), []byte(programa.Codigo), 0o644); err != nil {
+			return nil, err
+		}
+		programas[pacote] = programa
+		indices[pacote] = i
+	}
+	if len(programas) == 0 {
+		return resultados, nil
+	}
+
+	// Um único go build compila todos os trechos em paralelo; os erros vêm
+	// com o caminho do arquivo de cada pacote. Alguns erros interrompem o
+	// build inteiro, então os pacotes com erro são removidos e o build é
+	// repetido até não aparecerem erros novos.
+	falharam := map[string]bool{}
+	var saidaBuild string
+	for {
+		compilar := exec.Command("go", "build", "-o", "bin"+string(filepath.Separator), "./...")
+		compilar.Dir = dir
+		compilar.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=")
+		var stderr bytes.Buffer
+		compilar.Stderr = &stderr
+		if compilar.Run() == nil {
+			break
+		}
+		saidaBuild = stderr.String()
+
+		novos := 0
+		leitor := bufio.NewScanner(&stderr)
+		for leitor.Scan() {
+			m := erroDoCompilador.FindStringSubmatch(leitor.Text())
+			if m == nil || falharam[m[1]] {
+				continue
+			}
+			falharam[m[1]] = true
+			novos++
+			r := &resultados[indices[m[1]]]
+			r.Situacao = ErroCompilacao
+			r.Mensagem = m[3]
+			linha, _ := strconv.Atoi(m[2])
+			if origem := programas[m[1]].LinhaDoTrecho(linha); origem >= 0 {
+				r.Linha = r.Trecho.Linha + origem
+			}
+			if err := os.RemoveAll(filepath.Join(dir, m[1])); err != nil {
+				return nil, err
+			}
+		}
+		if novos == 0 {
+			break
+		}
+	}
+
+	var wg sync.WaitGroup
+	vagas := make(chan struct{}, runtime.NumCPU())
+	for pacote, i := range indices {
+		binario := filepath.Join(dir, "bin", pacote)
+		if falharam[pacote] {
+			continue
+		}
+		if _, err := os.Stat(binario); err != nil {
+			// Erro de compilação em um formato que não reconhecemos.
+			resultados[i].Situacao = ErroCompilacao
+			resultados[i].Mensagem = primeiraLinha(saidaBuild)
+			continue
+		}
+		if trechos[i].SoCompilar {
+			continue
+		}
+
+		wg.Add(1)
+		go func(r *Resultado) {
+			defer wg.Done()
+			vagas <- struct{}{}
+			defer func() { <-vagas }()
+			executar(binario, r)
+		}(&resultados[i])
+	}
+	wg.Wait()
+	return resultados, nil
+}
+
+// bibliotecaPadrao devolve os caminhos de todos os pacotes da biblioteca padrão.
+func bibliotecaPadrao() (map[string]bool, error) {
+	saida, err := exec.Command("go", "list", "std").Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list std: %w", err)
+	}
+	pacotes := map[string]bool{}
+	for _, caminho := range strings.Fields(string(saida)) {
+		pacotes[caminho] = true
+	}
+	return pacotes, nil
+}
+
+// importForaDaBiblioteca devolve a linha e o caminho do primeiro import que
+// não é da biblioteca padrão (os trechos são compilados sem dependências).
+func importForaDaBiblioteca(programa Programa, padrao map[string]bool) (int, string) {
+	fset := token.NewFileSet()
+	arquivo, err := parser.ParseFile(fset, "", programa.Codigo, parser.ImportsOnly)
+	if err != nil {
+		return 0, ""
+	}
+	for _, imp := range arquivo.Imports {
+		caminho, _ := strconv.Unquote(imp.Path.Value)
+		if !padrao[caminho] {
+			return fset.Position(imp.Pos()).Line, caminho
+		}
+	}
+	return 0, ""
+}
+
+func executar(binario string, r *Resultado) {
+	ctx, cancelar := context.WithTimeout(context.Background(), TempoLimiteExecucao)
+	defer cancelar()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, binario)
+	// Executa no diretório temporário: alguns trechos criam arquivos.
+	cmd.Dir = filepath.Dir(filepath.Dir(binario))
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		r.Situacao = ErroExecucao
+		if ctx.Err() != nil {
+			r.Mensagem = fmt.Sprintf("passou de %s executando (marque com %s se for esperado)", TempoLimiteExecucao, DiretivaSoCompilar)
+		} else {
+			r.Mensagem = primeiraLinha(stderr.String())
+		}
+		return
+	}
+
+	if r.Trecho.TemSaida && normalizarSaida(stdout.String()) != normalizarSaida(r.Trecho.Saida) {
+		r.Situacao = SaidaDiferente
+		r.Linha = r.Trecho.LinhaSaida
+		r.Mensagem = fmt.Sprintf("esperado:\n%s\nobtido:\n%s", r.Trecho.Saida, stdout.String())
+	}
+}
+
+// normalizarSaida ignora espaços no fim das linhas e linhas vazias no final.
+func normalizarSaida(saida string) string {
+	linhas := strings.Split(saida, "\n")
+	for i, linha := range linhas {
+		linhas[i] = strings.TrimRight(linha, " \t\r")
+	}
+	return strings.TrimRight(strings.Join(linhas, "\n"), "\n")
+}
+
+func primeiraLinha(texto string) string {
+	texto = strings.TrimSpace(texto)
+	if i := strings.IndexByte(texto, '\n'); i >= 0 {
+		return texto[:i]
+	}
+	return texto
+}
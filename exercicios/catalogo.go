@@ -0,0 +1,100 @@
+package exercicios
+
+var catalogo = []Exercicio{
+	{
+		Nome:       "loop-somar-pares",
+		Topico:     "loops",
+		Enunciado:  "Some apenas os números pares de 1 até n (inclusive).",
+		Funcao:     "SomarPares",
+		Assinatura: "func SomarPares(n int) int",
+		Casos: []Caso{
+			{Chamada: "SomarPares(10)", Esperado: "30", Dica: "Use o operador % para saber se o número é par."},
+			{Chamada: "SomarPares(1)", Esperado: "0", Dica: "1 é ímpar, então não entra na soma."},
+			{Chamada: "SomarPares(0)", Esperado: "0", Dica: "Quando n é 0 o loop nem deve executar."},
+			{Chamada: "SomarPares(7)", Esperado: "12", Dica: "O limite n também precisa ser considerado (use <=)."},
+		},
+	},
+	{
+		Nome:       "slice-remover-indice",
+		Topico:     "slice",
+		Enunciado:  "Remova o item do índice i e devolva o novo slice.",
+		Funcao:     "RemoverIndice",
+		Assinatura: "func RemoverIndice(numeros []int, i int) []int",
+		Casos: []Caso{
+			{Chamada: "RemoverIndice([]int{1, 2, 3, 4, 5}, 2)", Esperado: "[]int{1, 2, 4, 5}", Dica: "Use append(numeros[:i], numeros[i+1:]...) como em slice.RemoverItemPorIndice."},
+			{Chamada: "RemoverIndice([]int{1, 2, 3}, 0)", Esperado: "[]int{2, 3}", Dica: "Remover o primeiro item é o mesmo que numeros[1:]."},
+			{Chamada: "RemoverIndice([]int{1, 2, 3}, 2)", Esperado: "[]int{1, 2}", Dica: "Remover o último item não pode acessar um índice fora do slice."},
+			{Chamada: "RemoverIndice([]int{7}, 0)", Esperado: "[]int{}", Dica: "Um slice com um item vira um slice vazio (não nil)."},
+		},
+	},
+	{
+		Nome:       "slice-inverter",
+		Topico:     "slice",
+		Enunciado:  "Devolva um novo slice com os itens em ordem inversa, sem alterar o original.",
+		Funcao:     "Inverter",
+		Assinatura: "func Inverter(textos []string) []string",
+		Casos: []Caso{
+			{Chamada: `Inverter([]string{"a", "b", "c"})`, Esperado: `[]string{"c", "b", "a"}`, Dica: "Percorra o slice do último índice (len-1) até o 0."},
+			{Chamada: `Inverter([]string{})`, Esperado: `[]string{}`, Dica: "Crie o resultado com make([]string, len(textos))."},
+			{Chamada: `func() []string { original := []string{"x", "y"}; Inverter(original); return original }()`, Esperado: `[]string{"x", "y"}`, Dica: "Não altere o slice recebido: slices compartilham o mesmo array por baixo."},
+		},
+	},
+	{
+		Nome:       "map-contar-palavras",
+		Topico:     "maps",
+		Enunciado:  "Conte quantas vezes cada palavra aparece no slice.",
+		Funcao:     "ContarPalavras",
+		Assinatura: "func ContarPalavras(palavras []string) map[string]int",
+		Casos: []Caso{
+			{Chamada: `ContarPalavras([]string{"go", "java", "go"})`, Esperado: `map[string]int{"go": 2, "java": 1}`, Dica: "Use contagem[palavra]++: o zero value de int é 0."},
+			{Chamada: `ContarPalavras([]string{})`, Esperado: `map[string]int{}`, Dica: "Devolva um map vazio criado com make, e não um map nil."},
+			{Chamada: `ContarPalavras([]string{"Go", "go"})`, Esperado: `map[string]int{"Go": 1, "go": 1}`, Dica: "As chaves de um map diferenciam maiúsculas de minúsculas."},
+		},
+	},
+	{
+		Nome:       "map-inverter",
+		Topico:     "maps",
+		Enunciado:  "Troque chaves por valores (linguagem -> ano vira ano -> linguagem).",
+		Funcao:     "InverterMap",
+		Assinatura: "func InverterMap(dados map[string]string) map[string]string",
+		Casos: []Caso{
+			{Chamada: `InverterMap(map[string]string{"Golang": "2009", "Java": "1995"})`, Esperado: `map[string]string{"2009": "Golang", "1995": "Java"}`, Dica: "Percorra com for chave, valor := range dados e grave resultado[valor] = chave."},
+			{Chamada: `InverterMap(map[string]string{})`, Esperado: `map[string]string{}`, Dica: "Crie o map de resultado com make antes de gravar nele."},
+		},
+	},
+	{
+		Nome:       "ponteiro-dobrar",
+		Topico:     "ponteiro",
+		Enunciado:  "Dobre o valor da variável apontada pelo ponteiro.",
+		Funcao:     "Dobrar",
+		Assinatura: "func Dobrar(numero *int)",
+		Casos: []Caso{
+			{Chamada: "func() int { n := 5; Dobrar(&n); return n }()", Esperado: "10", Dica: "Altere o valor apontado com *numero = ..., e não o ponteiro."},
+			{Chamada: "func() int { n := -3; Dobrar(&n); return n }()", Esperado: "-6", Dica: "Números negativos também devem ser dobrados."},
+			{Chamada: "func() bool { Dobrar(nil); return true }()", Esperado: "true", Dica: "Verifique se o ponteiro é nil antes de usar *numero."},
+		},
+	},
+	{
+		Nome:       "ponteiro-trocar",
+		Topico:     "ponteiro",
+		Enunciado:  "Troque os valores das duas variáveis apontadas.",
+		Funcao:     "Trocar",
+		Assinatura: "func Trocar(a, b *int)",
+		Casos: []Caso{
+			{Chamada: "func() [2]int { a, b := 1, 2; Trocar(&a, &b); return [2]int{a, b} }()", Esperado: "[2]int{2, 1}", Dica: "Em Go é possível fazer *a, *b = *b, *a."},
+			{Chamada: "func() [2]int { a := 7; Trocar(&a, &a); return [2]int{a, a} }()", Esperado: "[2]int{7, 7}", Dica: "Os dois ponteiros podem apontar para a mesma variável."},
+		},
+	},
+	{
+		Nome:       "interface-somar-areas",
+		Topico:     "interfaces",
+		Enunciado:  "Some as áreas de todas as formas recebidas (qualquer tipo com o método Area() float64).",
+		Funcao:     "SomarAreas",
+		Assinatura: "func SomarAreas(formas ...interface{ Area() float64 }) float64",
+		Casos: []Caso{
+			{Chamada: "SomarAreas(quadradoTeste(2), quadradoTeste(3))", Esperado: "13.0", Dica: "Percorra as formas e acumule forma.Area()."},
+			{Chamada: "SomarAreas()", Esperado: "0.0", Dica: "Sem formas a soma é zero."},
+		},
+		Apoio: "type quadradoTeste float64\n\nfunc (q quadradoTeste) Area() float64 { return float64(q * q) }\n",
+	},
+}
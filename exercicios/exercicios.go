@@ -0,0 +1,58 @@
+package exercicios
+
+import (
+	"fmt"
+	"io"
+
+	"modulo/idioma"
+)
+
+// Caso é um teste escondido de um exercício.
+// Chamada e Esperado são expressões Go: Chamada usa a função do aluno
+// e o resultado é comparado com Esperado usando reflect.DeepEqual.
+type Caso struct {
+	Chamada  string
+	Esperado string
+	Dica     string
+}
+
+// Exercicio é uma tarefa prática de um tópico do curso.
+// Apoio é código Go extra (tipos auxiliares) compilado junto com a solução.
+type Exercicio struct {
+	Nome       string
+	Topico     string
+	Enunciado  string
+	Funcao     string
+	Assinatura string
+	Casos      []Caso
+	Apoio      string
+}
+
+// Todos devolve os exercícios disponíveis, na ordem dos tópicos do curso.
+func Todos() []Exercicio {
+	return catalogo
+}
+
+// Buscar procura um exercício pelo nome.
+func Buscar(nome string) (Exercicio, bool) {
+	for _, exercicio := range catalogo {
+		if exercicio.Nome == nome {
+			return exercicio, true
+		}
+	}
+	return Exercicio{}, false
+}
+
+// Listar escreve o nome, o tópico e o enunciado de cada exercício. Os
+// enunciados e as dicas ficam em português no catálogo e são traduzidos com
+// idioma.T na hora de mostrar.
+func Listar(w io.Writer) {
+	for _, exercicio := range catalogo {
+		fmt.Fprintf(w, "%-24s [%s] %s\n", exercicio.Nome, exercicio.Topico, idioma.T(exercicio.Enunciado))
+	}
+}
+
+// Modelo devolve o arquivo inicial que o aluno deve completar.
+func (e Exercicio) Modelo() string {
+	return fmt.Sprintf(idioma.T("package solucao\n\n// %s\n%s {\n\t// TODO: escreva sua solução aqui\n\tpanic(\"não implementado\")\n}\n"), idioma.T(e.Enunciado), e.Assinatura)
+}
@@ -0,0 +1,94 @@
+package exercicios
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"modulo/idioma"
+)
+
+// Cada exercício tem uma solução de referência em testdata/solucoes.
+// Se algum caso escondido estiver errado, a solução de referência falha aqui.
+func TestSolucoesDeReferenciaPassam(t *testing.T) {
+	for _, exercicio := range Todos() {
+		t.Run(exercicio.Nome, func(t *testing.T) {
+			t.Parallel()
+			resultado, err := Verificar(exercicio, filepath.Join("testdata", "solucoes", exercicio.Nome+".go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !resultado.Passou() {
+				var relatorio strings.Builder
+				resultado.Escrever(&relatorio)
+				t.Errorf("a solução de referência falhou:\n%s", relatorio.String())
+			}
+		})
+	}
+}
+
+func TestSolucaoIncorretaMostraDica(t *testing.T) {
+	exercicio, _ := Buscar("ponteiro-dobrar")
+	resultado, err := Verificar(exercicio, filepath.Join("testdata", "incorretas", "ponteiro-dobrar.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resultado.Aprovados() != 2 {
+		t.Fatalf("esperava 2 casos aprovados, obtido %d", resultado.Aprovados())
+	}
+
+	var relatorio strings.Builder
+	resultado.Escrever(&relatorio)
+	for _, trecho := range []string{"❌ caso 3 falhou com panic", exercicio.Casos[2].Dica, "Resultado: 2/3"} {
+		if !strings.Contains(relatorio.String(), trecho) {
+			t.Errorf("relatório não contém %q:\n%s", trecho, relatorio.String())
+		}
+	}
+}
+
+// O resultado não passa pela saída padrão, e os nomes do verificador não
+// colidem com os da solução.
+func TestSolucaoQueImprime(t *testing.T) {
+	exercicio, _ := Buscar("loop-somar-pares")
+	resultado, err := Verificar(exercicio, filepath.Join("testdata", "barulhenta", "loop-somar-pares.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resultado.Passou() || len(resultado.Casos) != len(exercicio.Casos) {
+		var relatorio strings.Builder
+		resultado.Escrever(&relatorio)
+		t.Errorf("a solução que imprime falhou:\n%s", relatorio.String())
+	}
+}
+
+func TestAssinaturaErrada(t *testing.T) {
+	exercicio, _ := Buscar("loop-somar-pares")
+	_, err := Verificar(exercicio, filepath.Join("testdata", "incorretas", "assinatura-errada.go"))
+	if err == nil || !strings.Contains(err.Error(), "deve ter a assinatura") {
+		t.Fatalf("esperava erro de assinatura, obtido %v", err)
+	}
+
+	exercicio, _ = Buscar("slice-inverter")
+	_, err = Verificar(exercicio, filepath.Join("testdata", "incorretas", "assinatura-errada.go"))
+	if err == nil || !strings.Contains(err.Error(), "não foi encontrada") {
+		t.Fatalf("esperava erro de função não encontrada, obtido %v", err)
+	}
+}
+
+// O catálogo de idioma só confere idioma.T com literais; os enunciados e as
+// dicas são traduzidos na hora de mostrar, então este teste confere os deles.
+func TestExerciciosTraduzidos(t *testing.T) {
+	idioma.Definir(idioma.Ingles)
+	defer idioma.Definir(idioma.Portugues)
+	for _, exercicio := range Todos() {
+		textos := []string{exercicio.Enunciado}
+		for _, caso := range exercicio.Casos {
+			textos = append(textos, caso.Dica)
+		}
+		for _, texto := range textos {
+			if idioma.T(texto) == texto {
+				t.Errorf("%s: %q não tem tradução em idioma/ingles.go", exercicio.Nome, texto)
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package solucao
+
+import "fmt"
+
+// Uma solução certa que imprime na saída padrão e declara nomes que o
+// verificador também poderia usar.
+
+type resultadoCaso struct{}
+
+var json, reflect = "json", "reflect"
+
+func executar() {}
+
+func main() {
+	fmt.Println("main da solução")
+}
+
+func SomarPares(n int) int {
+	fmt.Println("somando até", n)
+	fmt.Print(`{"caso": 99}`)
+	soma := 0
+	for i := 2; i <= n; i += 2 {
+		soma += i
+	}
+	return soma
+}
@@ -0,0 +1,5 @@
+package solucao
+
+func SomarPares(n int64) int {
+	return 0
+}
@@ -0,0 +1,6 @@
+package main
+
+// Esquece de verificar o ponteiro nil.
+func Dobrar(numero *int) {
+	*numero = *numero * 2
+}
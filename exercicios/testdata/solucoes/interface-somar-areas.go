@@ -0,0 +1,9 @@
+package solucao
+
+func SomarAreas(formas ...interface{ Area() float64 }) float64 {
+	total := 0.0
+	for _, forma := range formas {
+		total += forma.Area()
+	}
+	return total
+}
@@ -0,0 +1,9 @@
+package solucao
+
+func SomarPares(n int) int {
+	soma := 0
+	for i := 2; i <= n; i += 2 {
+		soma += i
+	}
+	return soma
+}
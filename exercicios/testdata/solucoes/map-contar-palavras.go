@@ -0,0 +1,9 @@
+package solucao
+
+func ContarPalavras(palavras []string) map[string]int {
+	contagem := make(map[string]int)
+	for _, palavra := range palavras {
+		contagem[palavra]++
+	}
+	return contagem
+}
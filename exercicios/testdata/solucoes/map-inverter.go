@@ -0,0 +1,9 @@
+package solucao
+
+func InverterMap(dados map[string]string) map[string]string {
+	invertido := make(map[string]string, len(dados))
+	for chave, valor := range dados {
+		invertido[valor] = chave
+	}
+	return invertido
+}
@@ -0,0 +1,8 @@
+package solucao
+
+func Dobrar(numero *int) {
+	if numero == nil {
+		return
+	}
+	*numero *= 2
+}
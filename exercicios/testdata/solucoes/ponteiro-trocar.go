@@ -0,0 +1,5 @@
+package solucao
+
+func Trocar(a, b *int) {
+	*a, *b = *b, *a
+}
@@ -0,0 +1,9 @@
+package solucao
+
+func Inverter(textos []string) []string {
+	invertido := make([]string, len(textos))
+	for i, texto := range textos {
+		invertido[len(textos)-1-i] = texto
+	}
+	return invertido
+}
@@ -0,0 +1,5 @@
+package solucao
+
+func RemoverIndice(numeros []int, i int) []int {
+	return append(numeros[:i], numeros[i+1:]...)
+}
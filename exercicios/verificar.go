@@ -0,0 +1,243 @@
+package exercicios
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"modulo/idioma"
+)
+
+// TempoLimite é quanto a compilação e execução de uma solução pode demorar
+// (protege contra loops infinitos na solução do aluno).
+var TempoLimite = 60 * time.Second
+
+// ResultadoCaso é o resultado de um caso escondido.
+type ResultadoCaso struct {
+	Caso   int    `json:"caso"`
+	Passou bool   `json:"passou"`
+	Obtido string `json:"obtido"`
+	Panico string `json:"panico"`
+}
+
+// Resultado reúne os casos executados de um exercício.
+type Resultado struct {
+	Exercicio Exercicio
+	Casos     []ResultadoCaso
+}
+
+// Aprovados devolve quantos casos passaram.
+func (r Resultado) Aprovados() int {
+	total := 0
+	for _, caso := range r.Casos {
+		if caso.Passou {
+			total++
+		}
+	}
+	return total
+}
+
+// Passou indica se todos os casos passaram.
+func (r Resultado) Passou() bool {
+	return r.Aprovados() == len(r.Casos)
+}
+
+// Escrever mostra caso a caso o que passou e, para os que falharam,
+// o valor obtido e a dica do exercício, no idioma atual. O valor esperado
+// não é mostrado.
+func (r Resultado) Escrever(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Exercício:"), r.Exercicio.Nome)
+	for _, caso := range r.Casos {
+		if caso.Passou {
+			fmt.Fprintf(w, idioma.T("✅ caso %d passou\n"), caso.Caso+1)
+			continue
+		}
+		if caso.Panico != "" {
+			fmt.Fprintf(w, idioma.T("❌ caso %d falhou com panic: %s\n"), caso.Caso+1, caso.Panico)
+		} else {
+			fmt.Fprintf(w, idioma.T("❌ caso %d falhou, valor obtido: %s\n"), caso.Caso+1, caso.Obtido)
+		}
+		fmt.Fprintln(w, idioma.T("   Dica:"), idioma.T(r.Exercicio.Casos[caso.Caso].Dica))
+	}
+	fmt.Fprintf(w, idioma.T("Resultado: %d/%d casos passaram\n"), r.Aprovados(), len(r.Casos))
+}
+
+// Verificar compila a solução do aluno junto com os casos escondidos do exercício,
+// executa o programa gerado e devolve o resultado de cada caso.
+// O pacote declarado no arquivo do aluno não importa: ele é trocado por main.
+func Verificar(e Exercicio, arquivo string) (Resultado, error) {
+	fset := token.NewFileSet()
+	solucao, err := parser.ParseFile(fset, arquivo, nil, parser.ParseComments)
+	if err != nil {
+		return Resultado{}, fmt.Errorf(idioma.T("erro de sintaxe na solução: %w"), err)
+	}
+	if err := conferirAssinatura(e, solucao); err != nil {
+		return Resultado{}, err
+	}
+
+	dir, err := os.MkdirTemp("", "exercicio-*")
+	if err != nil {
+		return Resultado{}, err
+	}
+	defer os.RemoveAll(dir)
+
+	solucao.Name.Name = "main"
+	// o verificador tem a própria func main; a do aluno, se existir, nunca
+	// é chamada
+	for _, decl := range solucao.Decls {
+		if funcao, ok := decl.(*ast.FuncDecl); ok && funcao.Recv == nil && funcao.Name.Name == "main" {
+			funcao.Name.Name = "mainDaSolucao"
+		}
+	}
+	var codigo bytes.Buffer
+	if err := format.Node(&codigo, fset, solucao); err != nil {
+		return Resultado{}, err
+	}
+	var verificador bytes.Buffer
+	if err := modeloVerificador.Execute(&verificador, e); err != nil {
+		return Resultado{}, err
+	}
+	arquivos := map[string]string{
+		"go.mod":         "module solucao\n\ngo 1.23\n",
+		"solucao.go":     codigo.String(),
+		"verificador.go": verificador.String(),
+	}
+	for nome, conteudo := range arquivos {
+		if err := os.WriteFile(filepath.Join(dir, nome), []byte(conteudo), 0o644); err != nil {
+			return Resultado{}, err
+		}
+	}
+
+	// o resultado vai para um arquivo, e não para a saída padrão, para que
+	// um fmt.Print na solução não se misture com o JSON
+	arquivoResultado := filepath.Join(dir, "resultado.json")
+	ctx, cancelar := context.WithTimeout(context.Background(), TempoLimite)
+	defer cancelar()
+	cmd := exec.CommandContext(ctx, "go", "run", ".", arquivoResultado)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return Resultado{}, fmt.Errorf(idioma.T("a solução demorou mais de %s (loop infinito?)"), TempoLimite)
+		}
+		return Resultado{}, fmt.Errorf(idioma.T("a solução não compilou:\n%s"), strings.ReplaceAll(stderr.String(), dir+string(filepath.Separator), ""))
+	}
+
+	saida, err := os.ReadFile(arquivoResultado)
+	if err != nil {
+		return Resultado{}, fmt.Errorf(idioma.T("o verificador não gravou o resultado (a solução chamou os.Exit?): %w"), err)
+	}
+	resultado := Resultado{Exercicio: e}
+	if err := json.Unmarshal(saida, &resultado.Casos); err != nil {
+		return Resultado{}, fmt.Errorf(idioma.T("saída inesperada do verificador: %w"), err)
+	}
+	return resultado, nil
+}
+
+// conferirAssinatura garante que a função pedida existe e tem os tipos de
+// parâmetros e retornos da assinatura do exercício (os nomes podem mudar).
+func conferirAssinatura(e Exercicio, solucao *ast.File) error {
+	esperado, err := parser.ParseFile(token.NewFileSet(), "", "package p\n"+e.Assinatura+" {}", 0)
+	if err != nil {
+		return fmt.Errorf(idioma.T("assinatura inválida no exercício %s: %w"), e.Nome, err)
+	}
+	tipoEsperado := tiposDaFuncao(esperado.Decls[0].(*ast.FuncDecl).Type)
+
+	for _, decl := range solucao.Decls {
+		funcao, ok := decl.(*ast.FuncDecl)
+		if !ok || funcao.Recv != nil || funcao.Name.Name != e.Funcao {
+			continue
+		}
+		if tipo := tiposDaFuncao(funcao.Type); tipo != tipoEsperado {
+			return fmt.Errorf(idioma.T("a função %s deve ter a assinatura %q, mas foi declarada como %q"), e.Funcao, e.Assinatura, tipo)
+		}
+		return nil
+	}
+	return fmt.Errorf(idioma.T("a função %s não foi encontrada na solução (assinatura esperada: %s)"), e.Funcao, e.Assinatura)
+}
+
+func tiposDaFuncao(tipo *ast.FuncType) string {
+	lista := func(campos *ast.FieldList) string {
+		if campos == nil {
+			return ""
+		}
+		var tipos []string
+		for _, campo := range campos.List {
+			repeticoes := max(len(campo.Names), 1)
+			for range repeticoes {
+				tipos = append(tipos, types.ExprString(campo.Type))
+			}
+		}
+		return strings.Join(tipos, ", ")
+	}
+	return "func(" + lista(tipo.Params) + ") (" + lista(tipo.Results) + ")"
+}
+
+// modeloVerificador gera o verificador, compilado no mesmo pacote da
+// solução para chamar as funções dela. Os nomes do verificador começam com
+// verificador, e os imports têm apelidos, para não colidir com os nomes da
+// solução.
+var modeloVerificador = template.Must(template.New("verificador").Parse(`package main
+
+import (
+	verificadorJSON "encoding/json"
+	verificadorFmt "fmt"
+	verificadorOS "os"
+	verificadorReflect "reflect"
+)
+
+{{.Apoio}}
+
+type verificadorCaso struct {
+	Caso   int    ` + "`json:\"caso\"`" + `
+	Passou bool   ` + "`json:\"passou\"`" + `
+	Obtido string ` + "`json:\"obtido\"`" + `
+	Panico string ` + "`json:\"panico\"`" + `
+}
+
+func verificadorExecutar(caso int, obter func() any, esperado any) (r verificadorCaso) {
+	r.Caso = caso
+	defer func() {
+		if p := recover(); p != nil {
+			r.Passou = false
+			r.Panico = verificadorFmt.Sprint(p)
+		}
+	}()
+	obtido := obter()
+	r.Obtido = verificadorFmt.Sprintf("%#v", obtido)
+	r.Passou = verificadorReflect.DeepEqual(obtido, esperado)
+	return r
+}
+
+// main grava os resultados no arquivo recebido como argumento.
+func main() {
+	resultados := []verificadorCaso{
+{{- range $i, $caso := .Casos}}
+		verificadorExecutar({{$i}}, func() any { return {{$caso.Chamada}} }, {{$caso.Esperado}}),
+{{- end}}
+	}
+	dados, err := verificadorJSON.Marshal(resultados)
+	if err == nil {
+		err = verificadorOS.WriteFile(verificadorOS.Args[1], dados, 0o644)
+	}
+	if err != nil {
+		verificadorFmt.Fprintln(verificadorOS.Stderr, err)
+		verificadorOS.Exit(1)
+	}
+}
+`))
@@ -0,0 +1,211 @@
+package expressao
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"modulo/idioma"
+	"modulo/rastro"
+)
+
+// Variaveis são os valores usados ao avaliar uma expressão: int ou bool.
+type Variaveis map[string]any
+
+// String lista as variáveis em ordem alfabética, como "a = 7, d = false".
+func (v Variaveis) String() string {
+	nomes := make([]string, 0, len(v))
+	for nome := range v {
+		nomes = append(nomes, nome)
+	}
+	slices.Sort(nomes)
+	partes := make([]string, len(nomes))
+	for i, nome := range nomes {
+		partes[i] = fmt.Sprintf("%s = %v", nome, v[nome])
+	}
+	return strings.Join(partes, ", ")
+}
+
+// LerVariaveis converte pares "nome=valor" (ex.: "a=7", "d=false") em Variaveis.
+func LerVariaveis(pares []string) (Variaveis, error) {
+	vars := Variaveis{}
+	for _, par := range pares {
+		nome, texto, ok := strings.Cut(par, "=")
+		nome = strings.TrimSpace(nome)
+		if !ok || nome == "" {
+			return nil, fmt.Errorf(idioma.T("%q: use nome=valor (ex.: a=7 ou d=false)"), par)
+		}
+		valor, err := lerValor(strings.TrimSpace(texto))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", nome, err)
+		}
+		vars[nome] = valor
+	}
+	return vars, nil
+}
+
+func lerValor(texto string) (any, error) {
+	switch texto {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	n, err := strconv.Atoi(texto)
+	if err != nil {
+		return nil, fmt.Errorf(idioma.T("valor %q não é int nem bool"), texto)
+	}
+	return n, nil
+}
+
+// Avaliar calcula a expressão com os valores de vars e emite em r cada passo:
+// as comparações feitas, os operandos pulados pelo curto-circuito de && e ||
+// e a combinação de cada par de resultados. r pode ser nil.
+func (e *Expressao) Avaliar(vars Variaveis, r *rastro.Rastreador) (bool, error) {
+	if r == nil {
+		r = rastro.De(io.Discard)
+	}
+	a := avaliador{vars: vars, r: r}
+	valor, err := a.valor(e.raiz, true)
+	if err != nil {
+		return false, err
+	}
+	resultado, ok := valor.(bool)
+	if !ok {
+		return false, fmt.Errorf(idioma.T("%s resulta em %T, esperava bool"), e.texto, valor)
+	}
+	return resultado, nil
+}
+
+type avaliador struct {
+	vars Variaveis
+	r    *rastro.Rastreador
+}
+
+// valor avalia n. Os passos de variáveis e literais só são mostrados quando
+// mostrar é true: dentro de "a > b" ou "!d" o passo do operador já mostra o valor.
+func (a avaliador) valor(n no, mostrar bool) (any, error) {
+	switch n := n.(type) {
+	case literal:
+		return n.valor, nil
+	case variavel:
+		valor, ok := a.vars[n.nome]
+		if !ok {
+			return nil, fmt.Errorf(idioma.T("a variável %s não tem valor"), n.nome)
+		}
+		if b, ok := valor.(bool); ok && mostrar {
+			a.r.Avaliar(fmt.Sprintf(idioma.T("Avalia %s"), n.nome), n.nome, b)
+		}
+		return valor, nil
+	case negacao:
+		x, err := a.booleano(n.x, "!", folha(n.x))
+		if err != nil {
+			return nil, err
+		}
+		a.r.Avaliar(fmt.Sprintf(idioma.T("Avalia %s"), n.texto), fmt.Sprintf("!%v", x), !x)
+		return !x, nil
+	case binario:
+		if n.op == "&&" || n.op == "||" {
+			return a.logico(n)
+		}
+		return a.relacional(n)
+	}
+	panic(fmt.Sprintf("expressao: nó desconhecido %T", n))
+}
+
+func folha(n no) bool {
+	switch n.(type) {
+	case literal, variavel:
+		return true
+	}
+	return false
+}
+
+func (a avaliador) booleano(n no, op string, silencioso bool) (bool, error) {
+	valor, err := a.valor(n, !silencioso)
+	if err != nil {
+		return false, err
+	}
+	b, ok := valor.(bool)
+	if !ok {
+		return false, fmt.Errorf(idioma.T("%s: o operador %s precisa de bool, mas o valor é %T"), n.fonte(), op, valor)
+	}
+	return b, nil
+}
+
+// logico avalia && e || com curto-circuito: o lado direito só é avaliado
+// quando o esquerdo não decide o resultado sozinho.
+func (a avaliador) logico(n binario) (any, error) {
+	esq, err := a.booleano(n.esq, n.op, false)
+	if err != nil {
+		return nil, err
+	}
+	if (n.op == "&&" && !esq) || (n.op == "||" && esq) {
+		a.r.Pular(fmt.Sprintf(idioma.T("%s é %v, então NÃO avalia %s (CURTO-CIRCUITO)"), n.esq.fonte(), esq, n.dir.fonte()), n.dir.fonte())
+		a.r.Concluir(fmt.Sprintf("%s = %v %s %s", n.texto, esq, n.op, idioma.T("(não avaliado)")), esq)
+		return esq, nil
+	}
+	dir, err := a.booleano(n.dir, n.op, false)
+	if err != nil {
+		return nil, err
+	}
+	resultado := dir // com o lado esquerdo sem decidir, o direito dá o resultado
+	a.r.Concluir(fmt.Sprintf("%s = %v %s %v", n.texto, esq, n.op, dir), resultado)
+	return resultado, nil
+}
+
+func (a avaliador) relacional(n binario) (any, error) {
+	esq, err := a.valor(n.esq, !folha(n.esq))
+	if err != nil {
+		return nil, err
+	}
+	dir, err := a.valor(n.dir, !folha(n.dir))
+	if err != nil {
+		return nil, err
+	}
+	resultado, err := comparar(n.op, esq, dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", n.texto, err)
+	}
+	a.r.Avaliar(fmt.Sprintf(idioma.T("Avalia %s"), n.texto), fmt.Sprintf("%v %s %v", esq, n.op, dir), resultado)
+	return resultado, nil
+}
+
+func comparar(op string, esq, dir any) (bool, error) {
+	switch esq := esq.(type) {
+	case int:
+		d, ok := dir.(int)
+		if !ok {
+			return false, fmt.Errorf(idioma.T("não é possível comparar int com %T"), dir)
+		}
+		switch op {
+		case "==":
+			return esq == d, nil
+		case "!=":
+			return esq != d, nil
+		case "<":
+			return esq < d, nil
+		case "<=":
+			return esq <= d, nil
+		case ">":
+			return esq > d, nil
+		case ">=":
+			return esq >= d, nil
+		}
+	case bool:
+		d, ok := dir.(bool)
+		if !ok {
+			return false, fmt.Errorf(idioma.T("não é possível comparar bool com %T"), dir)
+		}
+		switch op {
+		case "==":
+			return esq == d, nil
+		case "!=":
+			return esq != d, nil
+		}
+		return false, fmt.Errorf(idioma.T("o operador %s não funciona com bool"), op)
+	}
+	return false, fmt.Errorf(idioma.T("tipo %T não suportado"), esq)
+}
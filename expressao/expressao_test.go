@@ -0,0 +1,169 @@
+package expressao
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"modulo/rastro"
+)
+
+func TestAvaliar(t *testing.T) {
+	vars := Variaveis{"a": 7, "b": 5, "c": 12, "d": false, "v": true}
+	casos := []struct {
+		expressao string
+		esperado  bool
+	}{
+		{"a > b", true},
+		{"a > b && (c < 10 || !d)", true},
+		{"a < b && (c < 10 || !d)", false},
+		{"a > b && c < 10 || d", false},
+		{"!v || a == 7", true},
+		{"!!v", true},
+		{"(a >= 7) == v", true},
+		{"d != v", true},
+		{"-3 < b", true},
+		{"true && !false", true},
+		{"a <= -1", false},
+	}
+	for _, c := range casos {
+		e, err := Analisar(c.expressao)
+		if err != nil {
+			t.Errorf("Analisar(%q): %v", c.expressao, err)
+			continue
+		}
+		obtido, err := e.Avaliar(vars, nil)
+		if err != nil || obtido != c.esperado {
+			t.Errorf("%s = %v, %v; esperado %v", c.expressao, obtido, err, c.esperado)
+		}
+	}
+}
+
+func TestPassos(t *testing.T) {
+	e, err := Analisar("a > b && (c < 10 || !d)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := e.Avaliar(Variaveis{"a": 7, "b": 5, "c": 12, "d": false}, rastro.De(&buf)); err != nil {
+		t.Fatal(err)
+	}
+	esperado := `PASSO 1: Avalia a > b
+         7 > 5 = true
+PASSO 2: Avalia c < 10
+         12 < 10 = false
+PASSO 3: Avalia !d
+         !false = true
+PASSO 4: (c < 10 || !d) = false || true = true
+PASSO 5: a > b && (c < 10 || !d) = true && true = true
+`
+	if buf.String() != esperado {
+		t.Errorf("passos:\n%s\nesperado:\n%s", buf.String(), esperado)
+	}
+}
+
+func TestCurtoCircuito(t *testing.T) {
+	casos := map[string]string{
+		"a < b && (c < 10 || !d)": "(c < 10 || !d)",
+		"a > b || x":              "x",
+		"d && a > b":              "a > b",
+	}
+	for texto, pulado := range casos {
+		e, err := Analisar(texto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		saida := rastro.NovoJSON(nil)
+		// x não tem valor: se fosse avaliado, Avaliar devolveria erro.
+		if _, err := e.Avaliar(Variaveis{"a": 7, "b": 5, "c": 12, "d": false}, rastro.De(saida)); err != nil {
+			t.Errorf("%s: %v", texto, err)
+			continue
+		}
+		var pulos []string
+		for _, p := range saida.Passos() {
+			if p.Tipo == rastro.Pulo {
+				pulos = append(pulos, p.Expressao)
+			}
+		}
+		if len(pulos) != 1 || pulos[0] != pulado {
+			t.Errorf("%s: pulos = %q, esperado [%q]", texto, pulos, pulado)
+		}
+	}
+}
+
+func TestErroSintaxe(t *testing.T) {
+	casos := []struct {
+		expressao string
+		posicao   int
+		mensagem  string
+	}{
+		{"a > ", 4, "terminou antes"},
+		{"(a > b", 6, "esperava )"},
+		{"a = b", 2, "use =="},
+		{"a & b", 2, "use &&"},
+		{"a > b)", 5, "inesperado"},
+		{"a $ b", 2, "inesperado"},
+		{"a > - b", 6, "número depois de -"},
+	}
+	for _, c := range casos {
+		_, err := Analisar(c.expressao)
+		var sintaxe *ErroSintaxe
+		if !errors.As(err, &sintaxe) {
+			t.Errorf("Analisar(%q) = %v, esperava *ErroSintaxe", c.expressao, err)
+			continue
+		}
+		if sintaxe.Posicao != c.posicao || !strings.Contains(sintaxe.Mensagem, c.mensagem) {
+			t.Errorf("Analisar(%q) = posição %d %q; esperado %d %q", c.expressao, sintaxe.Posicao, sintaxe.Mensagem, c.posicao, c.mensagem)
+		}
+	}
+
+	_, err := Analisar("a > b)")
+	if marcado := err.(*ErroSintaxe).Marcar(); marcado != "a > b)\n     ^" {
+		t.Errorf("Marcar() = %q", marcado)
+	}
+}
+
+func TestErroAvaliacao(t *testing.T) {
+	vars := Variaveis{"a": 7, "d": false}
+	casos := map[string]string{
+		"a > z":  "z não tem valor",
+		"a && d": "precisa de bool",
+		"d < a":  "comparar bool com int",
+		"d < d":  "não funciona com bool",
+		"!a":     "precisa de bool",
+		"a":      "esperava bool",
+	}
+	for texto, mensagem := range casos {
+		e, err := Analisar(texto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := e.Avaliar(vars, nil); err == nil || !strings.Contains(err.Error(), mensagem) {
+			t.Errorf("%s: erro = %v, esperava %q", texto, err, mensagem)
+		}
+	}
+}
+
+func TestVariaveis(t *testing.T) {
+	e, err := Analisar("b > a && (a < 10 || !c)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nomes := strings.Join(e.Variaveis(), ","); nomes != "b,a,c" {
+		t.Errorf("Variaveis() = %s", nomes)
+	}
+
+	vars, err := LerVariaveis([]string{"a=7", "d = false", "n=-2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vars.String() != "a = 7, d = false, n = -2" {
+		t.Errorf("LerVariaveis = %s", vars)
+	}
+	for _, ruim := range []string{"a", "=1", "a=sim"} {
+		if _, err := LerVariaveis([]string{ruim}); err == nil {
+			t.Errorf("LerVariaveis(%q) deveria falhar", ruim)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package expressao
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"modulo/idioma"
+)
+
+type tipoToken int
+
+const (
+	tokFim tipoToken = iota
+	tokNome
+	tokNumero
+	tokOperador
+)
+
+// token é um pedaço da expressão; pos é o byte onde ele começa no texto.
+type token struct {
+	tipo  tipoToken
+	texto string
+	pos   int
+}
+
+// simbolos aceitos, com os de dois caracteres antes para "<=" não virar "<" e "=".
+var simbolos = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "-"}
+
+// ErroSintaxe aponta o lugar da expressão que não pôde ser lido.
+// Posicao é o byte do texto onde o problema começa (0 é o primeiro).
+type ErroSintaxe struct {
+	Texto    string
+	Posicao  int
+	Mensagem string
+}
+
+func (e *ErroSintaxe) Error() string {
+	return fmt.Sprintf(idioma.T("posição %d: %s"), e.Posicao+1, e.Mensagem)
+}
+
+// Marcar devolve a expressão com um ^ embaixo do lugar do erro.
+func (e *ErroSintaxe) Marcar() string {
+	coluna := utf8.RuneCountInString(e.Texto[:e.Posicao])
+	return e.Texto + "\n" + strings.Repeat(" ", coluna) + "^"
+}
+
+func separar(texto string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(texto); {
+		c, tamanho := utf8.DecodeRuneInString(texto[i:])
+		switch {
+		case unicode.IsSpace(c):
+			i += tamanho
+		case unicode.IsLetter(c) || c == '_':
+			inicio := i
+			for i < len(texto) {
+				c, tamanho = utf8.DecodeRuneInString(texto[i:])
+				if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+					break
+				}
+				i += tamanho
+			}
+			tokens = append(tokens, token{tokNome, texto[inicio:i], inicio})
+		case c >= '0' && c <= '9':
+			inicio := i
+			for i < len(texto) && texto[i] >= '0' && texto[i] <= '9' {
+				i++
+			}
+			tokens = append(tokens, token{tokNumero, texto[inicio:i], inicio})
+		default:
+			simbolo := ""
+			for _, s := range simbolos {
+				if strings.HasPrefix(texto[i:], s) {
+					simbolo = s
+					break
+				}
+			}
+			if simbolo == "" {
+				return nil, &ErroSintaxe{texto, i, caractereInesperado(c)}
+			}
+			tokens = append(tokens, token{tokOperador, simbolo, i})
+			i += len(simbolo)
+		}
+	}
+	return append(tokens, token{tokFim, "", len(texto)}), nil
+}
+
+// caractereInesperado explica os enganos mais comuns de quem vem de outras linguagens.
+func caractereInesperado(c rune) string {
+	switch c {
+	case '=':
+		return idioma.T("use == para comparar")
+	case '&':
+		return idioma.T("use && para o E lógico")
+	case '|':
+		return idioma.T("use || para o OU lógico")
+	}
+	return fmt.Sprintf(idioma.T("caractere %q inesperado"), c)
+}
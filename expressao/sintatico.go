@@ -0,0 +1,252 @@
+package expressao
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"modulo/idioma"
+)
+
+// no é uma parte da expressão; texto é o trecho original que ela ocupa,
+// usado para explicar os passos com as palavras do próprio aluno.
+type no interface {
+	fonte() string
+}
+
+type literal struct {
+	valor any
+	texto string
+}
+
+type variavel struct {
+	nome string
+}
+
+type negacao struct {
+	x     no
+	texto string
+}
+
+type binario struct {
+	op       string
+	esq, dir no
+	texto    string
+}
+
+func (n literal) fonte() string  { return n.texto }
+func (n variavel) fonte() string { return n.nome }
+func (n negacao) fonte() string  { return n.texto }
+func (n binario) fonte() string  { return n.texto }
+
+// Expressao é uma expressão booleana já analisada, pronta para ser avaliada
+// com valores diferentes.
+type Expressao struct {
+	texto string
+	raiz  no
+	nomes []string
+}
+
+// Analisar lê uma expressão no estilo do Go, como "a > b && (c < 10 || !d)".
+// São aceitos inteiros, true e false, variáveis, parênteses, !, && e ||
+// e os operadores relacionais ==, !=, <, <=, > e >=, com a mesma precedência
+// do Go: primeiro !, depois os relacionais, depois && e por último ||.
+func Analisar(texto string) (*Expressao, error) {
+	tokens, err := separar(texto)
+	if err != nil {
+		return nil, err
+	}
+	a := &analisador{texto: texto, tokens: tokens}
+	raiz, err := a.ou()
+	if err != nil {
+		return nil, err
+	}
+	if t := a.atual(); t.tipo != tokFim {
+		return nil, a.erro(t, fmt.Sprintf(idioma.T("%q inesperado depois da expressão"), t.texto))
+	}
+	return &Expressao{texto: strings.TrimSpace(texto), raiz: raiz, nomes: a.nomes}, nil
+}
+
+// String devolve a expressão como foi escrita.
+func (e *Expressao) String() string {
+	return e.texto
+}
+
+// Variaveis devolve os nomes das variáveis na ordem em que aparecem.
+func (e *Expressao) Variaveis() []string {
+	return append([]string(nil), e.nomes...)
+}
+
+type analisador struct {
+	texto  string
+	tokens []token
+	i      int
+	fim    int // byte onde termina o último token consumido
+	nomes  []string
+}
+
+func (a *analisador) atual() token {
+	return a.tokens[a.i]
+}
+
+func (a *analisador) avancar() token {
+	t := a.tokens[a.i]
+	if t.tipo != tokFim {
+		a.i++
+		a.fim = t.pos + len(t.texto)
+	}
+	return t
+}
+
+func (a *analisador) operador(ops ...string) (string, bool) {
+	t := a.atual()
+	if t.tipo != tokOperador {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.texto == op {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (a *analisador) trecho(inicio int) string {
+	return strings.TrimSpace(a.texto[inicio:a.fim])
+}
+
+func (a *analisador) erro(t token, mensagem string) error {
+	return &ErroSintaxe{a.texto, t.pos, mensagem}
+}
+
+// ou: e ( "||" e )*
+func (a *analisador) ou() (no, error) {
+	return a.binarios(a.e, "||")
+}
+
+// e: comparacao ( "&&" comparacao )*
+func (a *analisador) e() (no, error) {
+	return a.binarios(a.comparacao, "&&")
+}
+
+func (a *analisador) binarios(proximo func() (no, error), op string) (no, error) {
+	inicio := a.atual().pos
+	esq, err := proximo()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := a.operador(op); !ok {
+			return esq, nil
+		}
+		a.avancar()
+		dir, err := proximo()
+		if err != nil {
+			return nil, err
+		}
+		esq = binario{op: op, esq: esq, dir: dir, texto: a.trecho(inicio)}
+	}
+}
+
+// comparacao: unario ( relacional unario )?
+// Como no Go, "a < b < c" não é aceito: o resultado de a < b é bool.
+func (a *analisador) comparacao() (no, error) {
+	inicio := a.atual().pos
+	esq, err := a.unario()
+	if err != nil {
+		return nil, err
+	}
+	op, ok := a.operador("==", "!=", "<", "<=", ">", ">=")
+	if !ok {
+		return esq, nil
+	}
+	a.avancar()
+	dir, err := a.unario()
+	if err != nil {
+		return nil, err
+	}
+	return binario{op: op, esq: esq, dir: dir, texto: a.trecho(inicio)}, nil
+}
+
+// unario: "!" unario | primario
+func (a *analisador) unario() (no, error) {
+	if _, ok := a.operador("!"); !ok {
+		return a.primario()
+	}
+	inicio := a.avancar().pos
+	x, err := a.unario()
+	if err != nil {
+		return nil, err
+	}
+	return negacao{x: x, texto: a.trecho(inicio)}, nil
+}
+
+// primario: numero | "-" numero | true | false | nome | "(" ou ")"
+func (a *analisador) primario() (no, error) {
+	t := a.atual()
+	switch {
+	case t.tipo == tokNumero:
+		return a.numero(t.pos, "")
+	case t.tipo == tokOperador && t.texto == "-":
+		a.avancar()
+		if a.atual().tipo != tokNumero {
+			return nil, a.erro(a.atual(), idioma.T("esperava um número depois de -"))
+		}
+		return a.numero(t.pos, "-")
+	case t.tipo == tokNome:
+		a.avancar()
+		switch t.texto {
+		case "true":
+			return literal{valor: true, texto: t.texto}, nil
+		case "false":
+			return literal{valor: false, texto: t.texto}, nil
+		}
+		a.lembrar(t.texto)
+		return variavel{nome: t.texto}, nil
+	case t.tipo == tokOperador && t.texto == "(":
+		a.avancar()
+		dentro, err := a.ou()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := a.operador(")"); !ok {
+			return nil, a.erro(a.atual(), idioma.T("esperava )"))
+		}
+		a.avancar()
+		// O nó guarda o trecho com os parênteses, como o aluno escreveu.
+		return comParenteses(dentro, a.trecho(t.pos)), nil
+	case t.tipo == tokFim:
+		return nil, a.erro(t, idioma.T("a expressão terminou antes do esperado"))
+	}
+	return nil, a.erro(t, fmt.Sprintf(idioma.T("%q inesperado"), t.texto))
+}
+
+func (a *analisador) numero(inicio int, sinal string) (no, error) {
+	t := a.avancar()
+	n, err := strconv.Atoi(sinal + t.texto)
+	if err != nil {
+		return nil, a.erro(t, fmt.Sprintf(idioma.T("número %s muito grande"), t.texto))
+	}
+	return literal{valor: n, texto: a.trecho(inicio)}, nil
+}
+
+func (a *analisador) lembrar(nome string) {
+	for _, n := range a.nomes {
+		if n == nome {
+			return
+		}
+	}
+	a.nomes = append(a.nomes, nome)
+}
+
+func comParenteses(n no, texto string) no {
+	switch n := n.(type) {
+	case negacao:
+		n.texto = texto
+		return n
+	case binario:
+		n.texto = texto
+		return n
+	}
+	return n
+}
@@ -0,0 +1,204 @@
+package expressao
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"modulo/idioma"
+	"modulo/rastro"
+)
+
+// MaximoVariaveis limita o tamanho da tabela verdade (2^10 = 1024 linhas).
+const MaximoVariaveis = 10
+
+// Classificacao diz como o resultado de uma expressão varia na tabela verdade.
+type Classificacao int
+
+const (
+	// Contingencia é true em algumas linhas e false em outras.
+	Contingencia Classificacao = iota
+	// Tautologia é true em todas as linhas (ex.: a || !a).
+	Tautologia
+	// Contradicao é false em todas as linhas (ex.: a && !a).
+	Contradicao
+)
+
+func (c Classificacao) String() string {
+	switch c {
+	case Tautologia:
+		return idioma.T("TAUTOLOGIA (true em todas as linhas)")
+	case Contradicao:
+		return idioma.T("CONTRADIÇÃO (false em todas as linhas)")
+	}
+	return idioma.T("CONTINGÊNCIA (true em algumas linhas e false em outras)")
+}
+
+// Linha é uma combinação de valores e o que cada expressão deu com ela.
+// Valores segue a ordem de Tabela.Variaveis e Resultados e Pulados seguem
+// a ordem de Tabela.Expressoes. Pulados lista os trechos que o curto-circuito
+// não avaliou.
+type Linha struct {
+	Valores    []bool
+	Resultados []bool
+	Pulados    [][]string
+}
+
+// Tabela é a tabela verdade de uma ou mais expressões sobre as mesmas variáveis.
+type Tabela struct {
+	Variaveis  []string
+	Expressoes []*Expressao
+	Linhas     []Linha
+}
+
+// NovaTabela avalia as expressões com todas as combinações de true e false
+// das variáveis, começando por todas true, como nos livros de lógica.
+// Todas as variáveis são tratadas como bool.
+func NovaTabela(expressoes ...*Expressao) (*Tabela, error) {
+	if len(expressoes) == 0 {
+		return nil, errors.New(idioma.T("informe ao menos uma expressão"))
+	}
+	t := &Tabela{Expressoes: expressoes}
+	for _, e := range expressoes {
+		for _, nome := range e.nomes {
+			if !slices.Contains(t.Variaveis, nome) {
+				t.Variaveis = append(t.Variaveis, nome)
+			}
+		}
+	}
+	if len(t.Variaveis) > MaximoVariaveis {
+		return nil, fmt.Errorf(idioma.T("%d variáveis geram linhas demais; o máximo é %d"), len(t.Variaveis), MaximoVariaveis)
+	}
+
+	total := 1 << len(t.Variaveis)
+	for i := 0; i < total; i++ {
+		linha := Linha{Valores: make([]bool, len(t.Variaveis))}
+		vars := Variaveis{}
+		for j, nome := range t.Variaveis {
+			// O primeiro nome muda mais devagar: TT, TF, FT, FF.
+			linha.Valores[j] = i&(1<<(len(t.Variaveis)-1-j)) == 0
+			vars[nome] = linha.Valores[j]
+		}
+		for _, e := range expressoes {
+			passos := rastro.NovoJSON(nil)
+			resultado, err := e.Avaliar(vars, rastro.De(passos))
+			if err != nil {
+				return nil, err
+			}
+			var pulados []string
+			for _, p := range passos.Passos() {
+				if p.Tipo == rastro.Pulo {
+					pulados = append(pulados, p.Expressao)
+				}
+			}
+			linha.Resultados = append(linha.Resultados, resultado)
+			linha.Pulados = append(linha.Pulados, pulados)
+		}
+		t.Linhas = append(t.Linhas, linha)
+	}
+	return t, nil
+}
+
+// Classificar diz se a expressão de índice i é tautologia, contradição ou contingência.
+func (t *Tabela) Classificar(i int) Classificacao {
+	verdadeiras := 0
+	for _, linha := range t.Linhas {
+		if linha.Resultados[i] {
+			verdadeiras++
+		}
+	}
+	switch verdadeiras {
+	case len(t.Linhas):
+		return Tautologia
+	case 0:
+		return Contradicao
+	}
+	return Contingencia
+}
+
+// Diferencas devolve as linhas em que as expressões não dão o mesmo resultado.
+// As expressões são equivalentes quando não há nenhuma.
+func (t *Tabela) Diferencas() []Linha {
+	var diferentes []Linha
+	for _, linha := range t.Linhas {
+		for _, resultado := range linha.Resultados[1:] {
+			if resultado != linha.Resultados[0] {
+				diferentes = append(diferentes, linha)
+				break
+			}
+		}
+	}
+	return diferentes
+}
+
+// Equivalentes diz se todas as expressões dão o mesmo resultado em todas as linhas.
+func (t *Tabela) Equivalentes() bool {
+	return len(t.Diferencas()) == 0
+}
+
+// Descrever mostra os valores de uma linha, como "a = true, b = false".
+func (t *Tabela) Descrever(linha Linha) string {
+	partes := make([]string, len(t.Variaveis))
+	for i, nome := range t.Variaveis {
+		partes[i] = fmt.Sprintf("%s = %v", nome, linha.Valores[i])
+	}
+	return strings.Join(partes, ", ")
+}
+
+// Escrever mostra a tabela, a classificação de cada expressão e, quando há
+// mais de uma, se elas são equivalentes. A coluna de trechos não avaliados
+// mostra o curto-circuito de cada linha.
+func (t *Tabela) Escrever(w io.Writer) {
+	var buf bytes.Buffer
+	tw := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	var cabecalho []string
+	cabecalho = append(cabecalho, t.Variaveis...)
+	for _, e := range t.Expressoes {
+		cabecalho = append(cabecalho, e.String())
+	}
+	for _, e := range t.Expressoes {
+		if len(t.Expressoes) == 1 {
+			cabecalho = append(cabecalho, idioma.T("não avaliado"))
+		} else {
+			cabecalho = append(cabecalho, fmt.Sprintf(idioma.T("não avaliado em %s"), e))
+		}
+	}
+	fmt.Fprintln(tw, strings.Join(cabecalho, "\t"))
+	for _, linha := range t.Linhas {
+		var celulas []string
+		for _, v := range linha.Valores {
+			celulas = append(celulas, fmt.Sprint(v))
+		}
+		for _, r := range linha.Resultados {
+			celulas = append(celulas, fmt.Sprint(r))
+		}
+		for _, pulados := range linha.Pulados {
+			celulas = append(celulas, strings.Join(pulados, ", "))
+		}
+		fmt.Fprintln(tw, strings.Join(celulas, "\t"))
+	}
+	tw.Flush()
+	// Colunas vazias no fim da linha viram espaços; eles são tirados aqui.
+	for _, linha := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		fmt.Fprintln(w, strings.TrimRight(linha, " "))
+	}
+
+	fmt.Fprintln(w)
+	for i, e := range t.Expressoes {
+		fmt.Fprintf(w, "%s: %s\n", e, t.Classificar(i))
+	}
+	if len(t.Expressoes) < 2 {
+		return
+	}
+	diferencas := t.Diferencas()
+	if len(diferencas) == 0 {
+		fmt.Fprintln(w, idioma.T("EQUIVALENTES: as expressões dão o mesmo resultado em todas as linhas"))
+		return
+	}
+	fmt.Fprintf(w, idioma.T("NÃO EQUIVALENTES: os resultados diferem em %d de %d linhas, por exemplo com %s")+"\n",
+		len(diferencas), len(t.Linhas), t.Descrever(diferencas[0]))
+}
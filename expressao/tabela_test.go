@@ -0,0 +1,127 @@
+package expressao
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func analisar(t *testing.T, textos ...string) []*Expressao {
+	t.Helper()
+	var expressoes []*Expressao
+	for _, texto := range textos {
+		e, err := Analisar(texto)
+		if err != nil {
+			t.Fatalf("Analisar(%q): %v", texto, err)
+		}
+		expressoes = append(expressoes, e)
+	}
+	return expressoes
+}
+
+func TestTabela(t *testing.T) {
+	tabela, err := NovaTabela(analisar(t, "a && b")...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resultados []bool
+	var pulados []string
+	for _, linha := range tabela.Linhas {
+		resultados = append(resultados, linha.Resultados[0])
+		pulados = append(pulados, strings.Join(linha.Pulados[0], ","))
+	}
+	if !slices.Equal(resultados, []bool{true, false, false, false}) {
+		t.Errorf("resultados = %v", resultados)
+	}
+	if !slices.Equal(pulados, []string{"", "", "b", "b"}) {
+		t.Errorf("pulados = %q", pulados)
+	}
+	if d := tabela.Descrever(tabela.Linhas[1]); d != "a = true, b = false" {
+		t.Errorf("Descrever = %s", d)
+	}
+}
+
+func TestClassificar(t *testing.T) {
+	casos := map[string]Classificacao{
+		"a || !a":               Tautologia,
+		"a && !a":               Contradicao,
+		"a && b":                Contingencia,
+		"(a && b) || !a || !b":  Tautologia,
+		"true":                  Tautologia,
+		"a == b || a != b":      Tautologia,
+		"!(a || b) && (a || b)": Contradicao,
+	}
+	for texto, esperado := range casos {
+		tabela, err := NovaTabela(analisar(t, texto)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if obtido := tabela.Classificar(0); obtido != esperado {
+			t.Errorf("%s: %v, esperado %v", texto, obtido, esperado)
+		}
+	}
+}
+
+func TestEquivalentes(t *testing.T) {
+	casos := []struct {
+		a, b        string
+		equivalente bool
+	}{
+		{"!(a && b)", "!a || !b", true},
+		{"!(a || b)", "!a && !b", true},
+		{"a && (b || c)", "(a && b) || (a && c)", true},
+		{"!(a && b)", "!a && !b", false},
+		{"a", "a || (b && !b)", true},
+		{"a && b", "c", false},
+	}
+	for _, c := range casos {
+		tabela, err := NovaTabela(analisar(t, c.a, c.b)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tabela.Equivalentes() != c.equivalente {
+			t.Errorf("%s ≡ %s: %v, esperado %v", c.a, c.b, tabela.Equivalentes(), c.equivalente)
+		}
+	}
+
+	// As variáveis das duas expressões entram na tabela.
+	tabela, _ := NovaTabela(analisar(t, "a && b", "c")...)
+	if !slices.Equal(tabela.Variaveis, []string{"a", "b", "c"}) || len(tabela.Linhas) != 8 {
+		t.Errorf("variáveis = %v, linhas = %d", tabela.Variaveis, len(tabela.Linhas))
+	}
+}
+
+func TestTabelaErros(t *testing.T) {
+	if _, err := NovaTabela(); err == nil {
+		t.Error("esperava erro sem expressões")
+	}
+	if _, err := NovaTabela(analisar(t, "a > 1")...); err == nil {
+		t.Error("esperava erro ao comparar variável bool com int")
+	}
+	if _, err := NovaTabela(analisar(t, "a && b && c && d && e && f && g && h && i && j && k")...); err == nil {
+		t.Error("esperava erro com variáveis demais")
+	}
+}
+
+func TestEscrever(t *testing.T) {
+	tabela, err := NovaTabela(analisar(t, "!(a && b)", "!a || !b")...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	tabela.Escrever(&buf)
+	esperado := `a      b      !(a && b)  !a || !b  não avaliado em !(a && b)  não avaliado em !a || !b
+true   true   false      false
+true   false  true       true
+false  true   true       true      b                          !b
+false  false  true       true      b                          !b
+
+!(a && b): CONTINGÊNCIA (true em algumas linhas e false em outras)
+!a || !b: CONTINGÊNCIA (true em algumas linhas e false em outras)
+EQUIVALENTES: as expressões dão o mesmo resultado em todas as linhas
+`
+	if buf.String() != esperado {
+		t.Errorf("saída:\n%s\nesperado:\n%s", buf.String(), esperado)
+	}
+}
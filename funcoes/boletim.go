@@ -0,0 +1,42 @@
+package funcoes
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"modulo/idioma"
+	"modulo/notas"
+)
+
+// turma é o CSV que a lição BoletimDaTurma lê, no formato de notas.Ler.
+const turma = `nome,prova1,prova2,trabalho,recuperacao
+Ana,7,8,9,
+Bruno,4,5.5,3,7
+Carla,6,6,5.9,
+Davi,2,3.5,4,
+Elisa,5,6,5.5,
+`
+
+// BoletimDaTurma faz o que AlunoAprovado faz para uma turma inteira com o
+// pacote notas: pesos diferentes para cada avaliação, média arredondada para
+// o décimo mais próximo e recuperação para quem fica entre 4 e 6.
+func BoletimDaTurma(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Pesos 3, 3 e 4; aprovado com 6; recuperação a partir de 4, com média final 5:"))
+	fmt.Fprintln(w)
+
+	lida, err := notas.Ler(strings.NewReader(turma))
+	if err != nil {
+		fmt.Fprintln(w, err)
+		return
+	}
+	regras := notas.RegrasPadrao()
+	regras.Pesos = []float64{3, 3, 4}
+	relatorio, err := notas.AvaliarTurma(lida, regras)
+	if err != nil {
+		fmt.Fprintln(w, err)
+		return
+	}
+	relatorio.Escrever(w)
+}
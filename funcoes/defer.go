@@ -1,18 +1,22 @@
 package funcoes
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func Defer() {
-	fmt.Println("Funcao com Defer")
+func Defer(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Funcao com Defer"))
 }
 
-func SemDefer() {
-	fmt.Println("Funcao Sem Defer")
+func SemDefer(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Funcao Sem Defer"))
 }
 
-func AlunoAprovado(n1, n2 float64) bool {
-	defer fmt.Println("Media calculada. Resultado será retornado")
-	fmt.Println("Calculando media...")
+func AlunoAprovado(w io.Writer, n1, n2 float64) bool {
+	defer fmt.Fprintln(w, idioma.T("Media calculada. Resultado será retornado"))
+	fmt.Fprintln(w, idioma.T("Calculando media..."))
 	media := (n1 + n2) / 2
 	if media >= 6 {
 		return true
@@ -0,0 +1,139 @@
+package funcoes
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"modulo/idioma"
+)
+
+// As versões abaixo calculam o mesmo número que FuncaoRecursiva, mas sem
+// repetir contas e com *big.Int, que não estoura: FuncaoRecursiva usa int e
+// a partir de F(93) o resultado dá a volta. Posições negativas causam panic.
+
+// FibonacciMemorizado é a recursão de FuncaoRecursiva com memória: cada
+// posição é calculada uma vez só e guardada para as próximas chamadas.
+func FibonacciMemorizado(posicao int) *big.Int {
+	validarPosicao(posicao)
+	memoria := make([]*big.Int, posicao+1)
+	var fib func(n int) *big.Int
+	fib = func(n int) *big.Int {
+		if n <= 1 {
+			return big.NewInt(int64(n))
+		}
+		if memoria[n] == nil {
+			memoria[n] = new(big.Int).Add(fib(n-2), fib(n-1))
+		}
+		return memoria[n]
+	}
+	return new(big.Int).Set(fib(posicao))
+}
+
+// FibonacciIterativo sobe de F(0) e F(1) até a posição, guardando só os dois
+// últimos valores: n somas e memória constante.
+func FibonacciIterativo(posicao int) *big.Int {
+	validarPosicao(posicao)
+	anterior, atual := big.NewInt(0), big.NewInt(1)
+	for range posicao {
+		anterior.Add(anterior, atual)
+		anterior, atual = atual, anterior
+	}
+	return anterior
+}
+
+// FibonacciDuplicacao usa as identidades F(2k) = F(k)·(2·F(k+1) − F(k)) e
+// F(2k+1) = F(k)² + F(k+1)², que pulam da posição k para 2k: cerca de log₂(n)
+// passos, um para cada bit da posição.
+func FibonacciDuplicacao(posicao int) *big.Int {
+	validarPosicao(posicao)
+	a, b := big.NewInt(0), big.NewInt(1) // F(k) e F(k+1), começando em k = 0
+	c, d, t := new(big.Int), new(big.Int), new(big.Int)
+	for bit := bitsDe(posicao) - 1; bit >= 0; bit-- {
+		c.Lsh(b, 1).Sub(c, a).Mul(c, a) // F(2k)
+		d.Mul(a, a).Add(d, t.Mul(b, b)) // F(2k+1)
+		if posicao>>bit&1 == 0 {
+			a.Set(c)
+			b.Set(d)
+		} else {
+			a.Set(d)
+			b.Add(c, d) // F(2k+2)
+		}
+	}
+	return a
+}
+
+func bitsDe(n int) int {
+	bits := 0
+	for ; n > 0; n >>= 1 {
+		bits++
+	}
+	return bits
+}
+
+func validarPosicao(posicao int) {
+	if posicao < 0 {
+		panic(fmt.Sprintf("fibonacci: posição negativa %d", posicao))
+	}
+}
+
+// chamadasRecursiva faz a mesma conta que FuncaoRecursiva contando quantas
+// vezes a função é chamada.
+func chamadasRecursiva(posicao int) (resultado, chamadas int) {
+	var fib func(n int) int
+	fib = func(n int) int {
+		chamadas++
+		if n <= 1 {
+			return n
+		}
+		return fib(n-2) + fib(n-1)
+	}
+	return fib(posicao), chamadas
+}
+
+// chamadasMemorizado conta as chamadas de FibonacciMemorizado.
+func chamadasMemorizado(posicao int) int {
+	memoria := map[int]bool{}
+	chamadas := 0
+	var fib func(n int)
+	fib = func(n int) {
+		chamadas++
+		if n <= 1 || memoria[n] {
+			return
+		}
+		fib(n - 2)
+		fib(n - 1)
+		memoria[n] = true
+	}
+	fib(posicao)
+	return chamadas
+}
+
+// FibonacciChamadas compara quantas vezes cada versão trabalha para chegar
+// ao mesmo resultado e mostra o int de FuncaoRecursiva estourando.
+func FibonacciChamadas(w io.Writer) {
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, idioma.T("n\tF(n)\tCHAMADAS RECURSIVA\tCHAMADAS MEMORIZADO\tSOMAS ITERATIVO\tPASSOS DUPLICAÇÃO\t"))
+	for _, n := range []int{5, 10, 20, 25, 30} {
+		resultado, chamadas := chamadasRecursiva(n)
+		fmt.Fprintf(tw, "%d\t%d\t%d\t%d\t%d\t%d\t\n", n, resultado, chamadas, chamadasMemorizado(n), n, bitsDe(n))
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, idioma.T("A versão recursiva calcula as mesmas posições de novo e de novo: F(n) chama F(n-1) e F(n-2), que chamam F(n-2) e F(n-3)..."))
+	fmt.Fprintln(w, idioma.T("As chamadas crescem como o próprio F(n) (2·F(n+1) − 1), cerca de 1,6 vezes a cada posição."))
+
+	fmt.Fprintln(w)
+	for _, n := range []int{92, 93} {
+		var comInt int
+		for i, a, b := 0, 0, 1; i <= n; i, a, b = i+1, b, a+b {
+			comInt = a
+		}
+		fmt.Fprintf(w, idioma.T("F(%d) com int: %d, com big.Int: %s\n"), n, comInt, FibonacciDuplicacao(n))
+	}
+	fmt.Fprintln(w, idioma.T("F(93) passa do maior int (9223372036854775807) e dá a volta para um número negativo."))
+	fmt.Fprintf(w, idioma.T("F(1000) tem %d algarismos e a duplicação chega nele em %d passos.\n"), len(FibonacciDuplicacao(1000).String()), bitsDe(1000))
+}
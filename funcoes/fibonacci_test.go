@@ -0,0 +1,103 @@
+package funcoes
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+var versoes = []struct {
+	nome     string
+	calcular func(int) *big.Int
+}{
+	{"Memorizado", FibonacciMemorizado},
+	{"Iterativo", FibonacciIterativo},
+	{"Duplicacao", FibonacciDuplicacao},
+}
+
+func TestFibonacciIgualARecursiva(t *testing.T) {
+	for _, v := range versoes {
+		for n := range 30 {
+			if obtido, esperado := v.calcular(n), FuncaoRecursiva(n); obtido.Cmp(big.NewInt(int64(esperado))) != 0 {
+				t.Errorf("%s(%d) = %s, esperado %d", v.nome, n, obtido, esperado)
+			}
+		}
+	}
+}
+
+func TestFibonacciGrande(t *testing.T) {
+	casos := map[int]string{
+		92:  "7540113804746346429",
+		93:  "12200160415121876738",
+		100: "354224848179261915075",
+		300: "222232244629420445529739893461909967206666939096499764990979600",
+	}
+	for n, esperado := range casos {
+		for _, v := range versoes {
+			if obtido := v.calcular(n).String(); obtido != esperado {
+				t.Errorf("%s(%d) = %s, esperado %s", v.nome, n, obtido, esperado)
+			}
+		}
+	}
+	// As três versões concordam também em posições que não estão na tabela.
+	for _, n := range []int{127, 128, 1000, 4097} {
+		iterativo := FibonacciIterativo(n)
+		if FibonacciMemorizado(n).Cmp(iterativo) != 0 || FibonacciDuplicacao(n).Cmp(iterativo) != 0 {
+			t.Errorf("versões diferem em F(%d)", n)
+		}
+	}
+}
+
+func TestFibonacciResultadoIndependente(t *testing.T) {
+	for _, v := range versoes {
+		a := v.calcular(10)
+		a.SetInt64(0)
+		if b := v.calcular(10); b.Int64() != 55 {
+			t.Errorf("%s: mudar um resultado alterou o seguinte (%s)", v.nome, b)
+		}
+	}
+}
+
+func TestFibonacciPosicaoNegativa(t *testing.T) {
+	for _, v := range versoes {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s(-1) não causou panic", v.nome)
+				}
+			}()
+			v.calcular(-1)
+		}()
+	}
+}
+
+func TestChamadas(t *testing.T) {
+	for n := range 25 {
+		resultado, chamadas := chamadasRecursiva(n)
+		if esperado := 2*FuncaoRecursiva(n+1) - 1; chamadas != esperado || resultado != FuncaoRecursiva(n) {
+			t.Errorf("chamadasRecursiva(%d) = %d, %d; esperado %d chamadas", n, resultado, chamadas, esperado)
+		}
+		if esperado := max(1, 2*n-1); chamadasMemorizado(n) != esperado {
+			t.Errorf("chamadasMemorizado(%d) = %d, esperado %d", n, chamadasMemorizado(n), esperado)
+		}
+	}
+}
+
+func BenchmarkFibonacci(b *testing.B) {
+	for _, n := range []int{20, 30} {
+		b.Run(fmt.Sprintf("Recursiva/%d", n), func(b *testing.B) {
+			for range b.N {
+				FuncaoRecursiva(n)
+			}
+		})
+	}
+	for _, n := range []int{20, 30, 1000, 10000} {
+		for _, v := range versoes {
+			b.Run(fmt.Sprintf("%s/%d", v.nome, n), func(b *testing.B) {
+				for range b.N {
+					v.calcular(n)
+				}
+			})
+		}
+	}
+}
@@ -1,11 +1,15 @@
 package funcoes
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func FuncaoClosure() func() {
-	texto := "Dentro da funcao closure"
+func FuncaoClosure(w io.Writer) func() {
+	texto := idioma.T("Dentro da funcao closure")
 	var funcao = func() {
-		fmt.Println(texto)
+		fmt.Fprintln(w, texto)
 	}
 	return funcao
 }
@@ -0,0 +1,67 @@
+package funcoes
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"modulo/closures"
+	"modulo/idioma"
+	"modulo/relogio"
+)
+
+// FuncaoClosureComEstado vai além de FuncaoClosure: lá o closure só lê o
+// texto capturado; aqui os closures do pacote closures alteram as variáveis
+// capturadas, que continuam existindo entre uma chamada e outra.
+func FuncaoClosureComEstado(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Contador: cada chamada de Contador() cria um total novo, capturado pelas funções que ela devolve"))
+	incrementarA, atualA := closures.Contador()
+	incrementarB, atualB := closures.Contador()
+	incrementarA()
+	incrementarA()
+	incrementarB()
+	fmt.Fprintf(w, idioma.T("  contador A: %d, contador B: %d\n"), atualA(), atualB())
+
+	var wg sync.WaitGroup
+	for range 1000 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			incrementarA()
+		}()
+	}
+	wg.Wait()
+	fmt.Fprintf(w, idioma.T("  depois de 1000 goroutines incrementando A: %d\n"), atualA())
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("GeradorDeIDs: o próximo número fica capturado e protegido por um mutex"))
+	gerarID := closures.GeradorDeIDs("ALU-", 4)
+	fmt.Fprintln(w, " ", gerarID(), gerarID(), gerarID())
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("LimitadorDeTaxa: balde com 3 fichas e recarga de 2 por segundo"))
+	falso := relogio.NovoFalso(time.Time{})
+	permitir := closures.LimitadorDeTaxa(3, 2, falso)
+	for _, espera := range []time.Duration{0, 0, 0, 0, 250 * time.Millisecond, 250 * time.Millisecond, 2 * time.Second} {
+		falso.Avancar(espera)
+		resultado := idioma.T("aceita")
+		if !permitir() {
+			resultado = idioma.T("recusada")
+		}
+		fmt.Fprintf(w, "  +%v: %s\n", espera, resultado)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("UmaVez: a configuração é carregada na primeira chamada e reaproveitada nas outras"))
+	cargas := 0
+	configuracao := closures.UmaVez(func() (string, error) {
+		cargas++
+		return "pt-BR", nil
+	})
+	for range 3 {
+		idiomaConfigurado, _ := configuracao()
+		fmt.Fprintf(w, idioma.T("  idioma %s, cargas: %d\n"), idiomaConfigurado, cargas)
+	}
+}
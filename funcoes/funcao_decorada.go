@@ -0,0 +1,91 @@
+package funcoes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"modulo/decoradores"
+	"modulo/idioma"
+	"modulo/relogio"
+)
+
+// FuncaoDecorada mostra os decoradores do pacote decoradores em ação. Cada
+// um é um closure que guarda a função original e o próprio estado; Registrar
+// usa defer para escrever o resultado depois que a função decorada retorna.
+// Os tempos vêm de um relógio falso, para a saída ser sempre a mesma.
+func FuncaoDecorada(w io.Writer) {
+	ctx := context.Background()
+	falso := relogio.NovoFalso(time.Time{})
+
+	// buscarNota falha nas duas primeiras chamadas, como um serviço instável;
+	// o contador é uma variável capturada pelo closure
+	chamadas := 0
+	buscarNota := func(_ context.Context, aluno string) (float64, error) {
+		chamadas++
+		falso.Avancar(80 * time.Millisecond)
+		if chamadas <= 2 {
+			return 0, fmt.Errorf(idioma.T("serviço indisponível (chamada %d)"), chamadas)
+		}
+		return 7.5, nil
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Retentar (3 tentativas, espera de 100ms dobrando) por fora de Registrar, que mostra cada tentativa:"))
+	comRetentativa := decoradores.Compor(buscarNota,
+		decoradores.Retentar[string, float64](3, decoradores.Backoff{Inicial: 100 * time.Millisecond, Fator: 2, Relogio: falso}),
+		decoradores.Registrar[string, float64]("buscarNota", w, falso),
+	)
+	comRetentativa(ctx, "Ana")
+	fmt.Fprintln(w, idioma.T("Esperas entre as tentativas:"), falso.Esperas())
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("LimitarTempo (10ms) numa função que só para quando o contexto é cancelado:"))
+	lenta := func(ctx context.Context, aluno string) (float64, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+	_, err := decoradores.LimitarTempo[string, float64](10*time.Millisecond)(lenta)(ctx, "Bruno")
+	fmt.Fprintln(w, idioma.T("Erro:"), err)
+	fmt.Fprintln(w, "errors.Is(err, context.DeadlineExceeded):", errors.Is(err, context.DeadlineExceeded))
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Memorizar: a segunda chamada com a mesma entrada não chama a função:"))
+	calculos := 0
+	media := decoradores.Memorizar[string, float64]()(func(_ context.Context, aluno string) (float64, error) {
+		calculos++
+		return 8, nil
+	})
+	media(ctx, "Carla")
+	media(ctx, "Carla")
+	media(ctx, "Davi")
+	fmt.Fprintf(w, idioma.T("3 chamadas, %d cálculos\n"), calculos)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Disjuntor (abre com 2 falhas seguidas, pausa de 30s):"))
+	disjuntor := &decoradores.Disjuntor{FalhasMaximas: 2, Pausa: 30 * time.Second, Relogio: falso}
+	foraDoAr := true
+	protegida := decoradores.ComDisjuntor[string, float64](disjuntor)(func(_ context.Context, aluno string) (float64, error) {
+		if foraDoAr {
+			return 0, errors.New(idioma.T("serviço fora do ar"))
+		}
+		return 9, nil
+	})
+	chamar := func() {
+		nota, err := protegida(ctx, "Elisa")
+		if err != nil {
+			fmt.Fprintf(w, idioma.T("  erro: %v → disjuntor %v\n"), err, disjuntor.Estado())
+			return
+		}
+		fmt.Fprintf(w, idioma.T("  nota %v → disjuntor %v\n"), nota, disjuntor.Estado())
+	}
+	for range 3 {
+		chamar()
+	}
+	falso.Avancar(30 * time.Second)
+	fmt.Fprintln(w, idioma.T("  30s depois, o serviço voltou e o disjuntor está"), disjuntor.Estado())
+	foraDoAr = false
+	chamar()
+}
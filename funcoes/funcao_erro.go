@@ -0,0 +1,192 @@
+package funcoes
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"modulo/idioma"
+)
+
+// MediaMinima é a média que VerificarAprovacao exige para aprovar.
+const MediaMinima = 6.0
+
+// Os três motivos de VerificarAprovacao recusar um boletim: nenhuma nota,
+// uma nota fora da escala ou média baixa.
+var (
+	// ErrMediaInsuficiente: a média ficou abaixo de MediaMinima.
+	ErrMediaInsuficiente = errors.New("média insuficiente")
+	// ErrNotaInvalida: uma das notas está fora de 0 a 10.
+	ErrNotaInvalida = errors.New("nota inválida")
+	// ErrSemNotas: nenhuma nota foi informada.
+	ErrSemNotas = errors.New("sem notas")
+)
+
+// ErroAprovacao guarda as notas que VerificarAprovacao recebeu e, conforme o
+// motivo, a média calculada ou o índice da nota fora de 0 a 10; o campo que
+// não vale para o motivo fica zerado. Com errors.As, quem chama lê esses
+// números, por exemplo para dizer quantos pontos faltaram.
+type ErroAprovacao struct {
+	Notas   []float64
+	Media   float64 // só com ErrMediaInsuficiente
+	Posicao int     // só com ErrNotaInvalida: o índice da nota em Notas
+	Causa   error
+}
+
+func (e *ErroAprovacao) Error() string {
+	switch e.Causa {
+	case ErrMediaInsuficiente:
+		return fmt.Sprintf(idioma.T("média %.2f das notas %v é menor que %v"), e.Media, e.Notas, MediaMinima)
+	case ErrNotaInvalida:
+		return fmt.Sprintf(idioma.T("a nota %v (posição %d) está fora de 0 a 10"), e.Notas[e.Posicao], e.Posicao)
+	case ErrSemNotas:
+		return idioma.T("nenhuma nota informada")
+	}
+	return e.Causa.Error()
+}
+
+// Unwrap expõe o motivo, para comparar com errors.Is(err, ErrSemNotas) e os
+// outros.
+func (e *ErroAprovacao) Unwrap() error {
+	return e.Causa
+}
+
+// VerificarAprovacao é a versão de FuncaoPanic que devolve um erro em vez de
+// causar panic: calcula a média das notas e devolve um *ErroAprovacao se
+// alguma nota é inválida ou se a média não chega a MediaMinima. Quem chama
+// decide o que fazer, sem precisar de defer e recover.
+func VerificarAprovacao(notas ...float64) (media float64, err error) {
+	if len(notas) == 0 {
+		return 0, &ErroAprovacao{Causa: ErrSemNotas}
+	}
+	soma := 0.0
+	for i, nota := range notas {
+		// NaN falha em qualquer comparação e passaria pelo teste de faixa
+		if math.IsNaN(nota) || nota < 0 || nota > 10 {
+			return 0, &ErroAprovacao{Notas: notas, Posicao: i, Causa: ErrNotaInvalida}
+		}
+		soma += nota
+	}
+	media = soma / float64(len(notas))
+	if media < MediaMinima {
+		return media, &ErroAprovacao{Notas: notas, Media: media, Causa: ErrMediaInsuficiente}
+	}
+	return media, nil
+}
+
+// ErroPanic é um panic transformado em erro por CapturarPanic.
+type ErroPanic struct {
+	Valor  any    // o valor passado para panic
+	Funcao string // a função em que o panic aconteceu, como funcoes.exigirAprovacao
+	Local  string // o arquivo e a linha do panic, como funcao_erro.go:136
+	Pilha  []byte // a pilha de chamadas no momento do panic, como debug.Stack
+}
+
+// Error mostra só a função; o arquivo e a linha ficam em Local.
+func (e *ErroPanic) Error() string {
+	return fmt.Sprintf(idioma.T("panic em %s: %v"), e.Funcao, e.Valor)
+}
+
+// Unwrap devolve o valor do panic quando ele é um erro, para que errors.Is e
+// errors.As enxerguem através do ErroPanic.
+func (e *ErroPanic) Unwrap() error {
+	err, _ := e.Valor.(error)
+	return err
+}
+
+// CapturarPanic executa f e devolve um *ErroPanic se f causar panic, ou nil
+// se f terminar normalmente. Serve de ponte entre código que causa panic e
+// código que trata erros.
+func CapturarPanic(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			funcao, local := origemDoPanic()
+			err = &ErroPanic{Valor: r, Funcao: funcao, Local: local, Pilha: debug.Stack()}
+		}
+	}()
+	f()
+	return nil
+}
+
+// origemDoPanic procura, na pilha da função adiada, o primeiro quadro depois
+// de runtime.gopanic: é ali que panic foi chamado. Devolve a função e o
+// arquivo:linha.
+func origemDoPanic() (funcao, local string) {
+	pcs := make([]uintptr, 32)
+	quadros := runtime.CallersFrames(pcs[:runtime.Callers(0, pcs)])
+	depoisDoPanic := false
+	for {
+		quadro, mais := quadros.Next()
+		if depoisDoPanic && !strings.HasPrefix(quadro.Function, "runtime.") {
+			funcao = quadro.Function[strings.LastIndex(quadro.Function, "/")+1:]
+			return funcao, fmt.Sprintf("%s:%d", filepath.Base(quadro.File), quadro.Line)
+		}
+		if quadro.Function == "runtime.gopanic" {
+			depoisDoPanic = true
+		}
+		if !mais {
+			return "?", "?"
+		}
+	}
+}
+
+// exigirAprovacao é VerificarAprovacao no estilo de FuncaoPanic: em vez de
+// devolver o erro, causa panic com ele.
+func exigirAprovacao(notas ...float64) float64 {
+	media, err := VerificarAprovacao(notas...)
+	if err != nil {
+		panic(err)
+	}
+	return media
+}
+
+// FuncaoComErro compara os dois estilos: FuncaoPanic interrompe a função e
+// só o recover adiado fica sabendo do problema; VerificarAprovacao devolve um
+// erro que quem chama examina com errors.Is e errors.As. No fim, CapturarPanic
+// transforma de volta em erro um panic que carrega um erro.
+func FuncaoComErro(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Com panic e recover (FuncaoPanic(5, 4)):"))
+	FuncaoPanic(w, 5, 4)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Com erro (VerificarAprovacao):"))
+	for _, notas := range [][]float64{{7, 8}, {5, 4}, {9, 11}, nil} {
+		media, err := VerificarAprovacao(notas...)
+		if err == nil {
+			fmt.Fprintf(w, idioma.T("%v: aprovado com média %.2f\n"), notas, media)
+			continue
+		}
+		fmt.Fprintf(w, idioma.T("%v: erro: %v\n"), notas, err)
+
+		var aprovacao *ErroAprovacao
+		switch {
+		case errors.Is(err, ErrMediaInsuficiente) && errors.As(err, &aprovacao):
+			fmt.Fprintf(w, idioma.T("   errors.Is(err, ErrMediaInsuficiente) e errors.As: faltam %.2f pontos na média\n"), MediaMinima-aprovacao.Media)
+		case errors.Is(err, ErrNotaInvalida) && errors.As(err, &aprovacao):
+			fmt.Fprintf(w, idioma.T("   errors.Is(err, ErrNotaInvalida) e errors.As: corrija a nota da posição %d\n"), aprovacao.Posicao)
+		case errors.Is(err, ErrSemNotas):
+			fmt.Fprintln(w, idioma.T("   errors.Is(err, ErrSemNotas): nada para calcular"))
+		}
+	}
+
+	_, err := VerificarAprovacao(5, 4)
+	contexto := fmt.Errorf(idioma.T("boletim de %s: %w"), "Ana", err)
+	fmt.Fprintln(w, idioma.T("Com mais contexto (fmt.Errorf e %w):"), contexto)
+	fmt.Fprintln(w, idioma.T("   errors.Is continua encontrando a causa:"), errors.Is(contexto, ErrMediaInsuficiente))
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("De panic para erro (CapturarPanic):"))
+	err = CapturarPanic(func() { exigirAprovacao(5, 4) })
+	fmt.Fprintln(w, err)
+	var p *ErroPanic
+	if errors.As(err, &p) {
+		fmt.Fprintln(w, idioma.T("   errors.As(err, &ErroPanic): a pilha completa fica em Pilha e o valor do panic em Valor"))
+		fmt.Fprintln(w, idioma.T("   errors.Is(err, ErrMediaInsuficiente):"), errors.Is(err, ErrMediaInsuficiente))
+	}
+}
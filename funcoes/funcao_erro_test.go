@@ -0,0 +1,118 @@
+package funcoes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestVerificarAprovacao(t *testing.T) {
+	casos := []struct {
+		notas   []float64
+		media   float64
+		causa   error
+		posicao int
+	}{
+		{notas: []float64{7, 8}, media: 7.5},
+		{notas: []float64{6}, media: 6},
+		{notas: []float64{0, 10, 8}, media: 6},
+		{notas: []float64{5, 4}, media: 4.5, causa: ErrMediaInsuficiente},
+		{notas: []float64{5.99}, media: 5.99, causa: ErrMediaInsuficiente},
+		{notas: []float64{9, 11}, causa: ErrNotaInvalida, posicao: 1},
+		{notas: []float64{-1, 7}, causa: ErrNotaInvalida, posicao: 0},
+		{notas: []float64{math.NaN(), 7}, causa: ErrNotaInvalida, posicao: 0},
+		{notas: []float64{7, math.Inf(1)}, causa: ErrNotaInvalida, posicao: 1},
+		{notas: []float64{math.Inf(-1)}, causa: ErrNotaInvalida, posicao: 0},
+		{notas: nil, causa: ErrSemNotas},
+	}
+	for _, c := range casos {
+		media, err := VerificarAprovacao(c.notas...)
+		if media != c.media {
+			t.Errorf("VerificarAprovacao(%v) média = %v, esperado %v", c.notas, media, c.media)
+		}
+		if c.causa == nil {
+			if err != nil {
+				t.Errorf("VerificarAprovacao(%v) = %v, esperado nil", c.notas, err)
+			}
+			continue
+		}
+		if !errors.Is(err, c.causa) {
+			t.Errorf("VerificarAprovacao(%v) = %v, esperado errors.Is %v", c.notas, err, c.causa)
+			continue
+		}
+		var aprovacao *ErroAprovacao
+		if !errors.As(err, &aprovacao) {
+			t.Errorf("VerificarAprovacao(%v) = %T, esperado *ErroAprovacao", c.notas, err)
+			continue
+		}
+		if c.causa == ErrMediaInsuficiente && aprovacao.Media != c.media {
+			t.Errorf("ErroAprovacao.Media = %v, esperado %v", aprovacao.Media, c.media)
+		}
+		if c.causa == ErrNotaInvalida && aprovacao.Posicao != c.posicao {
+			t.Errorf("ErroAprovacao.Posicao = %d, esperado %d", aprovacao.Posicao, c.posicao)
+		}
+	}
+}
+
+func TestErroAprovacaoComContexto(t *testing.T) {
+	_, err := VerificarAprovacao(5, 4)
+	err = fmt.Errorf("boletim de %s: %w", "Ana", err)
+	if !errors.Is(err, ErrMediaInsuficiente) {
+		t.Fatalf("errors.Is através de fmt.Errorf falhou: %v", err)
+	}
+	var aprovacao *ErroAprovacao
+	if !errors.As(err, &aprovacao) || aprovacao.Media != 4.5 {
+		t.Fatalf("errors.As através de fmt.Errorf = %v, esperado média 4.5", aprovacao)
+	}
+	if errors.Is(err, ErrNotaInvalida) {
+		t.Errorf("errors.Is(%v, ErrNotaInvalida) = true, esperado false", err)
+	}
+}
+
+func TestCapturarPanic(t *testing.T) {
+	if err := CapturarPanic(func() {}); err != nil {
+		t.Errorf("CapturarPanic sem panic = %v, esperado nil", err)
+	}
+
+	err := CapturarPanic(func() { exigirAprovacao(5, 4) })
+	var p *ErroPanic
+	if !errors.As(err, &p) {
+		t.Fatalf("CapturarPanic = %T %v, esperado *ErroPanic", err, err)
+	}
+	if !errors.Is(err, ErrMediaInsuficiente) {
+		t.Errorf("errors.Is(%v, ErrMediaInsuficiente) = false, esperado true pelo Unwrap", err)
+	}
+	if p.Funcao != "funcoes.exigirAprovacao" || !strings.HasPrefix(p.Local, "funcao_erro.go:") {
+		t.Errorf("Funcao, Local = %q, %q, esperado funcoes.exigirAprovacao em funcao_erro.go", p.Funcao, p.Local)
+	}
+	if strings.Contains(err.Error(), p.Local) {
+		t.Errorf("Error() = %q mostra a linha, que muda a cada edição do arquivo", err)
+	}
+	if !strings.Contains(string(p.Pilha), "exigirAprovacao") {
+		t.Errorf("Pilha não mostra exigirAprovacao:\n%s", p.Pilha)
+	}
+
+	// um panic com um valor que não é erro não tem o que desembrulhar
+	err = CapturarPanic(func() { panic("texto") })
+	if !errors.As(err, &p) || p.Valor != "texto" || errors.Unwrap(err) != nil {
+		t.Errorf("CapturarPanic(panic(\"texto\")) = %#v", err)
+	}
+	if !strings.HasPrefix(p.Funcao, "funcoes.TestCapturarPanic.func") {
+		t.Errorf("Funcao = %q, esperado a função anônima do teste", p.Funcao)
+	}
+
+	// um panic do próprio runtime também é capturado
+	err = CapturarPanic(func() {
+		var m map[string]int
+		m["x"] = 1
+	})
+	if !errors.As(err, &p) {
+		t.Fatalf("CapturarPanic(map nil) = %v, esperado *ErroPanic", err)
+	}
+	var runtimeErr interface{ RuntimeError() }
+	if !errors.As(err, &runtimeErr) {
+		t.Errorf("errors.As(%v, runtime.Error) = false, esperado true", err)
+	}
+}
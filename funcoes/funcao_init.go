@@ -1,8 +1,12 @@
 package funcoes
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func FuncaoInit() {
-	fmt.Println("Funcao Init")
+func FuncaoInit(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Funcao Init"))
 }
 
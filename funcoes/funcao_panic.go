@@ -1,17 +1,21 @@
 package funcoes
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func recuperarExecucao(){
+func recuperarExecucao(w io.Writer){
 	if r := recover(); r != nil {
-		fmt.Println("Recuperado de panic:", r)
+		fmt.Fprintln(w, idioma.T("Recuperado de panic:"), r)
 	}
 }
 
-func FuncaoPanic(n1, n2 int8) {
-	defer recuperarExecucao()
+func FuncaoPanic(w io.Writer, n1, n2 int8) {
+	defer recuperarExecucao(w)
    if media := (n1 + n2) / 2; media < 6 {
-	panic("Media menor que 6")
+	panic(idioma.T("Media menor que 6"))
    }
-   fmt.Println("Media calculada. Resultado será retornado")
+   fmt.Fprintln(w, idioma.T("Media calculada. Resultado será retornado"))
 }
@@ -1,8 +1,11 @@
 package funcoes
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func FuncaoPonteiro(numero *int) {
-	fmt.Println("FuncaoPonteiro", *numero)
+func FuncaoPonteiro(w io.Writer, numero *int) {
+	fmt.Fprintln(w, "FuncaoPonteiro", *numero)
 	*numero = *numero * -1
 }
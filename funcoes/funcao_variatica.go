@@ -1,22 +1,25 @@
 package funcoes
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
 
-func FuncaoVariaticaComMaisDeUmParametro(numeros ...int) int {
+func FuncaoVariaticaComMaisDeUmParametro(w io.Writer, numeros ...int) int {
 	total := 0
 	for _, numero := range numeros {
 		total += numero
 	}
-	fmt.Println("FuncaoVariaticaComMaisDeUmParametro: ", total)
+	fmt.Fprintln(w, "FuncaoVariaticaComMaisDeUmParametro: ", total)
 	return total
 }
 
-func FuncaoVariaticaComMaisDeUmParametroComRetorno(texto string, numeros ...int) int {
+func FuncaoVariaticaComMaisDeUmParametroComRetorno(w io.Writer, texto string, numeros ...int) int {
 	total := 0
 	for _, numero := range numeros {
 		total += numero
 	}
-	fmt.Println("FuncaoVariaticaComMaisDeUmParametroComRetorno: ", texto, total)
+	fmt.Fprintln(w, "FuncaoVariaticaComMaisDeUmParametroComRetorno: ", texto, total)
 	return total
 }
\ No newline at end of file
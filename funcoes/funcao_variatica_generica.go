@@ -0,0 +1,49 @@
+package funcoes
+
+import (
+	"fmt"
+	"io"
+
+	"modulo/genericos"
+	"modulo/idioma"
+)
+
+// FuncaoVariaticaGenerica refaz FuncaoVariaticaComMaisDeUmParametro com
+// genericos.Somar, que recebe valores de qualquer tipo numérico, e usa as
+// outras funções do pacote para processar um slice de notas.
+func FuncaoVariaticaGenerica(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Somar com ints:"), genericos.Somar(1, 2, 3, 4, 10))
+	fmt.Fprintln(w, idioma.T("Somar com float64:"), genericos.Somar(7.5, 8.25, 6))
+
+	notas := []float64{7.5, 4, 9, 5.5, 6}
+	fmt.Fprintln(w, idioma.T("Somar com um slice (notas...):"), genericos.Somar(notas...))
+	fmt.Fprintln(w, idioma.T("Somar[uint8](200, 100) estoura como o uint8:"), genericos.Somar[uint8](200, 100))
+	fmt.Fprintln(w, idioma.T("Maximo com strings:"), genericos.Maximo("banana", "uva", "abacaxi"))
+	fmt.Fprintln(w, "Minimo(notas[0], notas[1:]...):", genericos.Minimo(notas[0], notas[1:]...))
+	fmt.Fprintln(w, idioma.T("Minimo() sem valores não compila: o primeiro parâmetro não é variádico."))
+
+	fmt.Fprintln(w)
+	nomes := []string{"Ana", "Bruno", "Carla", "Davi", "Elisa"}
+	alunos := genericos.Parear(nomes, notas)
+	fmt.Fprintln(w, idioma.T("Parear nomes e notas:"), alunos)
+
+	aprovados, reprovados := genericos.Particionar(alunos, func(a genericos.Par[string, float64]) bool {
+		return a.Segundo >= 6
+	})
+	nome := func(a genericos.Par[string, float64]) string { return a.Primeiro }
+	fmt.Fprintln(w, idioma.T("Particionar por nota >= 6:"), genericos.Mapear(aprovados, nome), genericos.Mapear(reprovados, nome))
+
+	notasAprovados := genericos.Mapear(aprovados, func(a genericos.Par[string, float64]) float64 { return a.Segundo })
+	fmt.Fprintf(w, idioma.T("Média dos aprovados: %.2f\n"), genericos.Somar(notasAprovados...)/float64(len(notasAprovados)))
+
+	acima := genericos.Filtrar(notas, func(n float64) bool { return n > 7 })
+	fmt.Fprintln(w, idioma.T("Filtrar notas acima de 7:"), acima)
+
+	iniciais := genericos.Reduzir(nomes, "", func(r, n string) string { return r + n[:1] })
+	fmt.Fprintln(w, idioma.T("Reduzir os nomes às iniciais:"), iniciais)
+
+	porTamanho := genericos.Agrupar(nomes, func(n string) int { return len(n) })
+	fmt.Fprintln(w, idioma.T("Agrupar os nomes pelo tamanho:"), porTamanho)
+	fmt.Fprintln(w, idioma.T("Último nome em ordem alfabética:"), genericos.Maximo(nomes[0], nomes[1:]...))
+}
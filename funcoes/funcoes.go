@@ -1,9 +1,13 @@
 package funcoes
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
 func FuncaoComRetorno(n1, n2 int8) string {
-	return fmt.Sprintf("Funcao com retorno: %d + %d = %d", n1, n2, n1 + n2)
+	return fmt.Sprintf(idioma.T("Funcao com retorno: %d + %d = %d"), n1, n2, n1 + n2)
 }
 
 func RecuperandoValorDaFuncaoComVariavel(n1, n2 int8) int8 {
@@ -11,12 +15,12 @@ func RecuperandoValorDaFuncaoComVariavel(n1, n2 int8) int8 {
 	return soma
 }
 
-func FuncaoSemRetorno() {
-	fmt.Println("Funcao sem retorno")
+func FuncaoSemRetorno(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Funcao sem retorno"))
 }
 
-var PassandoFuncaoParaVariavel = func(n1, n2 int8) int8 {
-	fmt.Println("Passando funcao para variavel")
+var PassandoFuncaoParaVariavel = func(w io.Writer, n1, n2 int8) int8 {
+	fmt.Fprintln(w, idioma.T("Passando funcao para variavel"))
 	return n1 + n2
 }
 
@@ -0,0 +1,132 @@
+package funcoes
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"modulo/idioma"
+	"modulo/relogio"
+)
+
+// Traco registra a entrada e a saída de funções, recuando cada chamada
+// conforme a profundidade, o que desenha a árvore de chamadas. Basta uma
+// linha no começo da função:
+//
+//	func f(n int) (r int, err error) {
+//		defer traco.Entrar("f", n)(&r, &err)
+//		...
+//	}
+//
+// Entrar escreve a entrada na hora e devolve a função que o defer chama na
+// saída; ela recebe ponteiros para os resultados nomeados e os lê depois do
+// return, quando já têm os valores devolvidos. Um Traco acompanha uma
+// goroutine só.
+type Traco struct {
+	w                  io.Writer
+	relogio            relogio.Relogio
+	profundidade       int
+	profundidadeMaxima int
+	chamadas           int
+}
+
+// NovoTraco cria um Traco que escreve em w e mede as durações com r (nil usa
+// relogio.Atual()).
+func NovoTraco(w io.Writer, r relogio.Relogio) *Traco {
+	if r == nil {
+		r = relogio.Atual()
+	}
+	return &Traco{w: w, relogio: r}
+}
+
+// Entrar registra a chamada de nome com os argumentos e devolve a função que
+// registra a saída. Ela recebe ponteiros para os resultados: um *error não
+// nil aparece como erro, os outros como valores.
+func (t *Traco) Entrar(nome string, argumentos ...any) func(resultados ...any) {
+	chamada := fmt.Sprintf("%s(%s)", nome, juntar(argumentos))
+	recuo := strings.Repeat("│  ", t.profundidade)
+	fmt.Fprintf(t.w, "%s→ %s\n", recuo, chamada)
+
+	t.chamadas++
+	t.profundidade++
+	t.profundidadeMaxima = max(t.profundidadeMaxima, t.profundidade)
+	inicio := t.relogio.Agora()
+
+	return func(resultados ...any) {
+		t.profundidade--
+		duracao := t.relogio.Agora().Sub(inicio)
+
+		var valores []any
+		var erro error
+		for _, p := range resultados {
+			if e, ok := p.(*error); ok {
+				erro = *e
+				continue
+			}
+			valores = append(valores, reflect.ValueOf(p).Elem().Interface())
+		}
+		saida := chamada
+		if len(valores) > 0 {
+			saida += " = " + juntar(valores)
+		}
+		if erro != nil {
+			saida += fmt.Sprintf(idioma.T(" erro: %v"), erro)
+		}
+		fmt.Fprintf(t.w, "%s← %s [%v]\n", recuo, saida, duracao)
+	}
+}
+
+// Chamadas conta as chamadas registradas até agora.
+func (t *Traco) Chamadas() int {
+	return t.chamadas
+}
+
+// ProfundidadeMaxima é o maior número de chamadas abertas ao mesmo tempo.
+func (t *Traco) ProfundidadeMaxima() int {
+	return t.profundidadeMaxima
+}
+
+func juntar(valores []any) string {
+	textos := make([]string, len(valores))
+	for i, v := range valores {
+		textos[i] = fmt.Sprint(v)
+	}
+	return strings.Join(textos, ", ")
+}
+
+// funcaoRecursivaRastreada é FuncaoRecursiva com um Traco: cada chamada
+// aparece recuada sob a que a chamou. Cada chamada avança o relógio falso em
+// 1ms, e assim a duração de cada uma mostra o custo do ramo que ela abre.
+func funcaoRecursivaRastreada(t *Traco, falso *relogio.Falso, posicao int) (resultado int) {
+	defer t.Entrar("FuncaoRecursiva", posicao)(&resultado)
+	falso.Avancar(time.Millisecond)
+	if posicao <= 1 {
+		return posicao
+	}
+	return funcaoRecursivaRastreada(t, falso, posicao-2) + funcaoRecursivaRastreada(t, falso, posicao-1)
+}
+
+// verificarRastreado mostra um erro devolvido aparecendo no Traco. Como nas
+// chamadas de funcaoRecursivaRastreada, a verificação custa 1ms no relógio
+// falso.
+func verificarRastreado(t *Traco, falso *relogio.Falso, notas ...float64) (media float64, err error) {
+	defer t.Entrar("VerificarAprovacao", notas)(&media, &err)
+	falso.Avancar(time.Millisecond)
+	return VerificarAprovacao(notas...)
+}
+
+// RecursaoRastreada desenha a árvore de chamadas de FuncaoRecursiva(4): cada
+// posição abaixo de 2 é calculada mais de uma vez.
+func RecursaoRastreada(w io.Writer) {
+	falso := relogio.NovoFalso(time.Time{})
+	t := NovoTraco(w, falso)
+	fmt.Fprintln(w)
+	funcaoRecursivaRastreada(t, falso, 4)
+	fmt.Fprintf(w, idioma.T("%d chamadas, profundidade máxima %d\n"), t.Chamadas(), t.ProfundidadeMaxima())
+
+	fmt.Fprintln(w)
+	verificarRastreado(t, falso, 7, 8)
+	verificarRastreado(t, falso, 5, 4)
+}
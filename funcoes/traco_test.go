@@ -0,0 +1,72 @@
+package funcoes
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"modulo/relogio"
+)
+
+func TestTracoRecursao(t *testing.T) {
+	var saida bytes.Buffer
+	falso := relogio.NovoFalso(time.Time{})
+	traco := NovoTraco(&saida, falso)
+	if r := funcaoRecursivaRastreada(traco, falso, 5); r != FuncaoRecursiva(5) {
+		t.Errorf("funcaoRecursivaRastreada(5) = %d, esperado %d", r, FuncaoRecursiva(5))
+	}
+	_, chamadas := chamadasRecursiva(5)
+	if traco.Chamadas() != chamadas {
+		t.Errorf("Chamadas() = %d, esperado %d", traco.Chamadas(), chamadas)
+	}
+	if traco.ProfundidadeMaxima() != 5 {
+		t.Errorf("ProfundidadeMaxima() = %d, esperado 5", traco.ProfundidadeMaxima())
+	}
+
+	linhas := strings.Split(strings.TrimSuffix(saida.String(), "\n"), "\n")
+	if len(linhas) != 2*chamadas {
+		t.Fatalf("%d linhas, esperado %d (entrada e saída de cada chamada)", len(linhas), 2*chamadas)
+	}
+	if linhas[0] != "→ FuncaoRecursiva(5)" {
+		t.Errorf("primeira linha = %q", linhas[0])
+	}
+	if esperado := "← FuncaoRecursiva(5) = 5 [15ms]"; linhas[len(linhas)-1] != esperado {
+		t.Errorf("última linha = %q, esperado %q", linhas[len(linhas)-1], esperado)
+	}
+	if esperado := "│  │  │  │  → FuncaoRecursiva(1)"; !strings.Contains(saida.String(), esperado) {
+		t.Errorf("saída sem a chamada mais funda %q:\n%s", esperado, saida.String())
+	}
+}
+
+func TestTracoErro(t *testing.T) {
+	var saida bytes.Buffer
+	falso := relogio.NovoFalso(time.Time{})
+	traco := NovoTraco(&saida, falso)
+	_, err := verificarRastreado(traco, falso, 5, 4)
+	if !errors.Is(err, ErrMediaInsuficiente) {
+		t.Fatalf("verificarRastreado = %v, esperado ErrMediaInsuficiente", err)
+	}
+	if esperado := "← VerificarAprovacao([5 4]) = 4.5 erro: média 4.50"; !strings.Contains(saida.String(), esperado) {
+		t.Errorf("saída sem %q:\n%s", esperado, saida.String())
+	}
+	if esperado := "é menor que 6 [1ms]\n"; !strings.HasSuffix(saida.String(), esperado) {
+		t.Errorf("saída sem %q:\n%s", esperado, saida.String())
+	}
+}
+
+func TestTracoSemResultados(t *testing.T) {
+	var saida bytes.Buffer
+	traco := NovoTraco(&saida, relogio.NovoFalso(time.Time{}))
+	func() {
+		defer traco.Entrar("externa", "a", 1)()
+		func() {
+			defer traco.Entrar("interna")()
+		}()
+	}()
+	esperado := "→ externa(a, 1)\n│  → interna()\n│  ← interna() [0s]\n← externa(a, 1) [0s]\n"
+	if saida.String() != esperado {
+		t.Errorf("saída:\n%s\nwant:\n%s", saida.String(), esperado)
+	}
+}
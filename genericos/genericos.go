@@ -0,0 +1,122 @@
+// Package genericos reúne funções sobre slices que servem para qualquer tipo:
+// o tipo dos elementos é um parâmetro, decidido em cada chamada, em vez de
+// uma função para []int, outra para []string e assim por diante. Os nomes
+// em inglês, comuns em outras linguagens, aparecem entre parênteses.
+package genericos
+
+import "cmp"
+
+// Mapear (Map) devolve um slice novo com f aplicada a cada elemento.
+func Mapear[T, R any](s []T, f func(T) R) []R {
+	r := make([]R, 0, len(s))
+	for _, v := range s {
+		r = append(r, f(v))
+	}
+	return r
+}
+
+// Filtrar (Filter) devolve os elementos para os quais manter é true, na
+// ordem em que aparecem.
+func Filtrar[T any](s []T, manter func(T) bool) []T {
+	var r []T
+	for _, v := range s {
+		if manter(v) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+// Reduzir (Reduce) junta os elementos num único valor: começa em inicial e
+// passa o acumulado e cada elemento para f. O acumulado pode ter um tipo
+// diferente do dos elementos.
+func Reduzir[T, A any](s []T, inicial A, f func(A, T) A) A {
+	acumulado := inicial
+	for _, v := range s {
+		acumulado = f(acumulado, v)
+	}
+	return acumulado
+}
+
+// Agrupar (GroupBy) separa os elementos pela chave que chave devolve para
+// cada um. Dentro de cada grupo a ordem original é mantida.
+func Agrupar[T any, K comparable](s []T, chave func(T) K) map[K][]T {
+	grupos := make(map[K][]T)
+	for _, v := range s {
+		k := chave(v)
+		grupos[k] = append(grupos[k], v)
+	}
+	return grupos
+}
+
+// Particionar (Partition) separa os elementos em dois slices: os que
+// satisfazem f e os que não satisfazem.
+func Particionar[T any](s []T, f func(T) bool) (sim, nao []T) {
+	for _, v := range s {
+		if f(v) {
+			sim = append(sim, v)
+		} else {
+			nao = append(nao, v)
+		}
+	}
+	return sim, nao
+}
+
+// Par guarda dois valores que podem ter tipos diferentes.
+type Par[A, B any] struct {
+	Primeiro A
+	Segundo  B
+}
+
+// Parear (Zip) junta os elementos de mesma posição de a e b. O resultado
+// tem o tamanho do menor slice; o que sobra do maior é ignorado.
+func Parear[A, B any](a []A, b []B) []Par[A, B] {
+	n := min(len(a), len(b))
+	r := make([]Par[A, B], n)
+	for i := range n {
+		r[i] = Par[A, B]{a[i], b[i]}
+	}
+	return r
+}
+
+// Numero reúne os tipos inteiros e de ponto flutuante do Go (e os definidos
+// a partir deles). Os números complexos ficam de fora.
+type Numero interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Somar (Sum) soma os valores, que podem ser de qualquer tipo numérico.
+// Sem valores, devolve zero. A soma é feita no próprio tipo e estoura
+// como ele: Somar[uint8](200, 100) dá 44.
+func Somar[T Numero](valores ...T) T {
+	var total T
+	for _, v := range valores {
+		total += v
+	}
+	return total
+}
+
+// Minimo (Min) devolve o menor valor. O primeiro parâmetro não é variádico
+// para que chamar sem nenhum valor seja um erro de compilação, e não um
+// panic ou um zero inventado.
+func Minimo[T cmp.Ordered](primeiro T, outros ...T) T {
+	menor := primeiro
+	for _, v := range outros {
+		if v < menor {
+			menor = v
+		}
+	}
+	return menor
+}
+
+// Maximo (Max) devolve o maior valor; como em Minimo, pede pelo menos um.
+func Maximo[T cmp.Ordered](primeiro T, outros ...T) T {
+	maior := primeiro
+	for _, v := range outros {
+		if v > maior {
+			maior = v
+		}
+	}
+	return maior
+}
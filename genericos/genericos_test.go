@@ -0,0 +1,149 @@
+package genericos
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapear(t *testing.T) {
+	textos := Mapear([]int{1, 20, 300}, strconv.Itoa)
+	if esperado := []string{"1", "20", "300"}; !reflect.DeepEqual(textos, esperado) {
+		t.Errorf("Mapear(strconv.Itoa) = %q, esperado %q", textos, esperado)
+	}
+	tamanhos := Mapear(textos, func(s string) int { return len(s) })
+	if esperado := []int{1, 2, 3}; !reflect.DeepEqual(tamanhos, esperado) {
+		t.Errorf("Mapear(len) = %v, esperado %v", tamanhos, esperado)
+	}
+	if vazio := Mapear(nil, strconv.Itoa); len(vazio) != 0 {
+		t.Errorf("Mapear(nil) = %v, esperado vazio", vazio)
+	}
+}
+
+func TestFiltrar(t *testing.T) {
+	pares := Filtrar([]int{1, 2, 3, 4, 5, 6}, func(n int) bool { return n%2 == 0 })
+	if esperado := []int{2, 4, 6}; !reflect.DeepEqual(pares, esperado) {
+		t.Errorf("Filtrar(pares) = %v, esperado %v", pares, esperado)
+	}
+	if nenhum := Filtrar([]string{"a", "b"}, func(string) bool { return false }); len(nenhum) != 0 {
+		t.Errorf("Filtrar(false) = %q, esperado vazio", nenhum)
+	}
+}
+
+func TestReduzir(t *testing.T) {
+	if soma := Reduzir([]int{1, 2, 3, 4}, 0, func(a, n int) int { return a + n }); soma != 10 {
+		t.Errorf("Reduzir(+) = %d, esperado 10", soma)
+	}
+	// o acumulado tem outro tipo: junta ints num texto
+	texto := Reduzir([]int{1, 2, 3}, "", func(a string, n int) string { return a + strconv.Itoa(n) })
+	if texto != "123" {
+		t.Errorf("Reduzir(concatenar) = %q, esperado %q", texto, "123")
+	}
+	if r := Reduzir(nil, 42, func(a, n int) int { return a + n }); r != 42 {
+		t.Errorf("Reduzir(nil) = %d, esperado o inicial 42", r)
+	}
+}
+
+func TestAgrupar(t *testing.T) {
+	palavras := []string{"go", "rust", "c", "java", "zig", "ada"}
+	grupos := Agrupar(palavras, func(s string) int { return len(s) })
+	esperado := map[int][]string{
+		1: {"c"},
+		2: {"go"},
+		3: {"zig", "ada"},
+		4: {"rust", "java"},
+	}
+	if !reflect.DeepEqual(grupos, esperado) {
+		t.Errorf("Agrupar(len) = %v, esperado %v", grupos, esperado)
+	}
+	if vazio := Agrupar(nil, func(s string) int { return len(s) }); len(vazio) != 0 {
+		t.Errorf("Agrupar(nil) = %v, esperado vazio", vazio)
+	}
+}
+
+func TestParticionar(t *testing.T) {
+	notas := []float64{7.5, 4, 9, 5.9, 6}
+	aprovadas, reprovadas := Particionar(notas, func(n float64) bool { return n >= 6 })
+	if esperado := []float64{7.5, 9, 6}; !reflect.DeepEqual(aprovadas, esperado) {
+		t.Errorf("aprovadas = %v, esperado %v", aprovadas, esperado)
+	}
+	if esperado := []float64{4, 5.9}; !reflect.DeepEqual(reprovadas, esperado) {
+		t.Errorf("reprovadas = %v, esperado %v", reprovadas, esperado)
+	}
+	if len(aprovadas)+len(reprovadas) != len(notas) {
+		t.Errorf("Particionar perdeu elementos: %d + %d != %d", len(aprovadas), len(reprovadas), len(notas))
+	}
+}
+
+func TestParear(t *testing.T) {
+	nomes := []string{"Ana", "Bruno", "Carla"}
+	notas := []float64{8, 5.5}
+	pares := Parear(nomes, notas)
+	esperado := []Par[string, float64]{{"Ana", 8}, {"Bruno", 5.5}}
+	if !reflect.DeepEqual(pares, esperado) {
+		t.Errorf("Parear = %v, esperado %v (o tamanho do menor)", pares, esperado)
+	}
+	if vazio := Parear(nomes, []int(nil)); len(vazio) != 0 {
+		t.Errorf("Parear(nomes, nil) = %v, esperado vazio", vazio)
+	}
+}
+
+func TestSomar(t *testing.T) {
+	if obtido := Somar(1, 2, 3, 4, 10); obtido != 20 {
+		t.Errorf("Somar(ints) = %d, esperado 20", obtido)
+	}
+	if obtido := Somar(0.5, 0.25); obtido != 0.75 {
+		t.Errorf("Somar(floats) = %v, esperado 0.75", obtido)
+	}
+	if obtido := Somar[int](); obtido != 0 {
+		t.Errorf("Somar() = %d, esperado 0", obtido)
+	}
+	notas := []float64{7, 8, 9}
+	if obtido := Somar(notas...); obtido != 24 {
+		t.Errorf("Somar(notas...) = %v, esperado 24", obtido)
+	}
+	// a soma acontece no tipo dos valores e estoura como ele
+	if obtido := Somar[uint8](200, 100); obtido != 44 {
+		t.Errorf("Somar[uint8](200, 100) = %d, esperado 44", obtido)
+	}
+	type nota float32
+	if obtido := Somar(nota(6), nota(1.5)); obtido != 7.5 {
+		t.Errorf("Somar(tipo definido) = %v, esperado 7.5", obtido)
+	}
+}
+
+func TestMinimoMaximo(t *testing.T) {
+	if obtido := Minimo(3, 1, 2); obtido != 1 {
+		t.Errorf("Minimo(3, 1, 2) = %d, esperado 1", obtido)
+	}
+	if obtido := Maximo(3, 1, 2); obtido != 3 {
+		t.Errorf("Maximo(3, 1, 2) = %d, esperado 3", obtido)
+	}
+	if obtido := Minimo(-2.5); obtido != -2.5 {
+		t.Errorf("Minimo(-2.5) = %v, esperado -2.5", obtido)
+	}
+	frutas := []string{"uva", "banana", "abacaxi"}
+	if obtido := Minimo(frutas[0], frutas[1:]...); obtido != "abacaxi" {
+		t.Errorf("Minimo(frutas) = %q, esperado %q", obtido, "abacaxi")
+	}
+	if obtido := Maximo(frutas[0], frutas[1:]...); obtido != "uva" {
+		t.Errorf("Maximo(frutas) = %q, esperado %q", obtido, "uva")
+	}
+}
+
+// As funções combinam: o resultado de uma é a entrada da outra.
+func TestCombinando(t *testing.T) {
+	linhas := []string{"ana 8", "bruno 4", "carla 9.5", "davi 6"}
+	notas := Mapear(linhas, func(l string) float64 {
+		n, _ := strconv.ParseFloat(strings.Fields(l)[1], 64)
+		return n
+	})
+	aprovadas := Filtrar(notas, func(n float64) bool { return n >= 6 })
+	if obtido := Somar(aprovadas...) / float64(len(aprovadas)); obtido != 7.833333333333333 {
+		t.Errorf("média das aprovadas = %v, esperado 7.833333333333333", obtido)
+	}
+	if obtido := Maximo(notas[0], notas[1:]...); obtido != 9.5 {
+		t.Errorf("maior nota = %v, esperado 9.5", obtido)
+	}
+}
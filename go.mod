@@ -3,8 +3,9 @@ module modulo
 go 1.23.0
 
 require (
-	github.com/badoux/checkmail v1.2.4 // indirect
-	github.com/cpuguy83/go-md2man/v2 v2.0.7 // indirect
-	github.com/russross/blackfriday/v2 v2.1.0 // indirect
-	github.com/urfave/cli v1.22.17 // indirect
+	github.com/badoux/checkmail v1.2.4
+	github.com/russross/blackfriday/v2 v2.1.0
+	github.com/urfave/cli v1.22.17
 )
+
+require github.com/cpuguy83/go-md2man/v2 v2.0.7 // indirect
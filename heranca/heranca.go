@@ -1,6 +1,10 @@
 package heranca
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
 type Animal struct {
 	Nome  string
@@ -19,6 +23,6 @@ type Gato struct {
 	Raça string
 }
 
-func Heranca() {
-	fmt.Println("Herança")
+func Heranca(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Herança"))
 }
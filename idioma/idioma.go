@@ -0,0 +1,98 @@
+package idioma
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Idioma é um dos idiomas em que as lições podem escrever.
+type Idioma string
+
+const (
+	Portugues Idioma = "pt-BR"
+	Ingles    Idioma = "en"
+)
+
+// catalogos guarda as traduções de cada idioma. As chaves são os textos
+// originais em português, então o código das lições continua legível e o
+// português não precisa de catálogo.
+var catalogos = map[Idioma]map[string]string{
+	Ingles: ingles,
+}
+
+var atual atomic.Value
+
+func init() {
+	atual.Store(Portugues)
+}
+
+// Definir troca o idioma usado por T.
+func Definir(i Idioma) {
+	atual.Store(i)
+}
+
+// Atual devolve o idioma em uso.
+func Atual() Idioma {
+	return atual.Load().(Idioma)
+}
+
+// Escolher converte um valor como "en", "pt-BR" ou "en_US.UTF-8" em um idioma.
+func Escolher(valor string) (Idioma, error) {
+	codigo := strings.ToLower(valor)
+	if i := strings.IndexAny(codigo, ".@"); i >= 0 {
+		codigo = codigo[:i]
+	}
+	codigo = strings.ReplaceAll(codigo, "_", "-")
+
+	switch {
+	case codigo == "pt" || strings.HasPrefix(codigo, "pt-"):
+		return Portugues, nil
+	case codigo == "en" || strings.HasPrefix(codigo, "en-"):
+		return Ingles, nil
+	}
+	return "", fmt.Errorf("idioma %q não suportado (use %s ou %s)", valor, Portugues, Ingles)
+}
+
+// DoAmbiente lê o idioma de LC_ALL, LC_MESSAGES ou LANG, nessa ordem,
+// como os programas de terminal costumam fazer. Sem nenhum idioma
+// suportado nessas variáveis, o curso fica em português.
+func DoAmbiente() Idioma {
+	for _, variavel := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		valor := os.Getenv(variavel)
+		if valor == "" {
+			continue
+		}
+		if i, err := Escolher(valor); err == nil {
+			return i
+		}
+		// Um valor como "C.UTF-8" vale para a variável, mesmo sem ser suportado.
+		break
+	}
+	return Portugues
+}
+
+// Configurar define o idioma pelo valor de --idioma ou, quando ele está
+// vazio, pelas variáveis de ambiente.
+func Configurar(valor string) error {
+	if valor == "" {
+		Definir(DoAmbiente())
+		return nil
+	}
+	i, err := Escolher(valor)
+	if err != nil {
+		return err
+	}
+	Definir(i)
+	return nil
+}
+
+// T traduz um texto das lições para o idioma atual. Textos sem tradução
+// são devolvidos como estão.
+func T(texto string) string {
+	if traducao, ok := catalogos[Atual()][texto]; ok {
+		return traducao
+	}
+	return texto
+}
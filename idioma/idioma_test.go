@@ -0,0 +1,116 @@
+package idioma
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEscolher(t *testing.T) {
+	casos := []struct {
+		valor    string
+		esperado Idioma
+	}{
+		{"pt-BR", Portugues},
+		{"pt", Portugues},
+		{"pt_BR.UTF-8", Portugues},
+		{"en", Ingles},
+		{"EN", Ingles},
+		{"en_US.UTF-8", Ingles},
+		{"en_GB@euro", Ingles},
+	}
+	for _, caso := range casos {
+		obtido, err := Escolher(caso.valor)
+		if err != nil || obtido != caso.esperado {
+			t.Errorf("Escolher(%q) = %q, %v; esperado %q", caso.valor, obtido, err, caso.esperado)
+		}
+	}
+	for _, invalido := range []string{"", "C", "fr_FR.UTF-8", "english"} {
+		if _, err := Escolher(invalido); err == nil {
+			t.Errorf("Escolher(%q) deveria falhar", invalido)
+		}
+	}
+}
+
+func TestDoAmbiente(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "")
+	t.Setenv("LANG", "en_US.UTF-8")
+	if i := DoAmbiente(); i != Ingles {
+		t.Errorf("LANG=en_US.UTF-8: %q", i)
+	}
+
+	// LC_ALL tem prioridade sobre LANG, mesmo com um idioma não suportado.
+	t.Setenv("LC_ALL", "C.UTF-8")
+	if i := DoAmbiente(); i != Portugues {
+		t.Errorf("LC_ALL=C.UTF-8: %q", i)
+	}
+}
+
+func TestT(t *testing.T) {
+	defer Definir(Portugues)
+
+	Definir(Ingles)
+	if obtido := T("Você é maior de idade"); obtido != "You are an adult" {
+		t.Errorf("T em inglês = %q", obtido)
+	}
+	if obtido := T("texto sem tradução"); obtido != "texto sem tradução" {
+		t.Errorf("texto sem tradução deveria voltar igual, obtido %q", obtido)
+	}
+
+	Definir(Portugues)
+	if obtido := T("Você é maior de idade"); obtido != "Você é maior de idade" {
+		t.Errorf("T em português = %q", obtido)
+	}
+}
+
+// Todo idioma.T("...") do repositório precisa de tradução no catálogo em inglês.
+func TestCatalogoCompleto(t *testing.T) {
+	fset := token.NewFileSet()
+	err := filepath.WalkDir("..", func(caminho string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && (d.Name() == "testdata" || strings.HasPrefix(d.Name(), ".")) && caminho != ".." {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(caminho, ".go") {
+			return nil
+		}
+		arquivo, err := parser.ParseFile(fset, caminho, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(arquivo, func(n ast.Node) bool {
+			chamada, ok := n.(*ast.CallExpr)
+			if !ok || len(chamada.Args) != 1 {
+				return true
+			}
+			seletor, ok := chamada.Fun.(*ast.SelectorExpr)
+			if !ok || seletor.Sel.Name != "T" {
+				return true
+			}
+			if pacote, ok := seletor.X.(*ast.Ident); !ok || pacote.Name != "idioma" {
+				return true
+			}
+			literal, ok := chamada.Args[0].(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				return true
+			}
+			texto, _ := strconv.Unquote(literal.Value)
+			if _, ok := ingles[texto]; !ok {
+				t.Errorf("%s: %q não tem tradução em inglês", fset.Position(literal.Pos()), texto)
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
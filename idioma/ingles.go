@@ -0,0 +1,565 @@
+package idioma
+
+// ingles traduz os textos das lições, os títulos dos tópicos e a ajuda da
+// aplicação de linha de comando. Ao criar um texto novo com T, adicione a
+// tradução aqui (o teste do pacote avisa quando alguma está faltando).
+var ingles = map[string]string{
+	// Títulos dos tópicos
+	"MODULOS INTERNOS - MODIFICADOR DE ACESSO PUBLIC": "INTERNAL MODULES - PUBLIC ACCESS MODIFIER",
+	"MODULOS EXTERNOS - Checkmail":                    "EXTERNAL MODULES - Checkmail",
+	"VARIAVEIS":                                       "VARIABLES",
+	"TIPOS DE DADOS":                                  "DATA TYPES",
+	"OPERADORES":                                      "OPERATORS",
+	"LOOPS":                                           "LOOPS",
+	"HERANÇA":                                         "INHERITANCE",
+	"PONTEIRO":                                        "POINTER",
+	"FUNÇÕES":                                         "FUNCTIONS",
+	"FUNÇÕES AVANÇADAS":                               "ADVANCED FUNCTIONS",
+	"METODOS":                                         "METHODS",
+	"INTERFACE GENERICA":                              "GENERIC INTERFACE",
+
+	// main.go
+	"\n✅ Programa executado com sucesso!": "\n✅ Program finished successfully!",
+
+	// servir.go
+	"endereço onde o site vai escutar":                      "address the site listens on",
+	"aluno que terá as lições abertas marcadas como vistas": "student whose opened lessons are marked as seen",
+	"Playground do curso em http://%s\n":                    "Course playground at http://%s\n",
+
+	// modificador_acesso
+	"Funcao Publica":     "Public Function",
+	"Funcao Nao Publica": "Non-Public Function",
+
+	// variaveis
+	"VARIAVEL IMPLICITA:":       "IMPLICIT VARIABLE:",
+	"VARIAVEL EXPLICITA:":       "EXPLICIT VARIABLE:",
+	"Nome: %s, Sobrenome: %s\n": "First name: %s, Last name: %s\n",
+	"O sobrenome é %s":          "The last name is %s",
+
+	// tiposdedados
+	"ERRO:":                                   "ERROR:",
+	"erro de teste":                           "test error",
+	"INSPETOR DE TIPOS NUMERICOS: ":           "NUMERIC TYPE INSPECTOR: ",
+	"CONVERTENDO %s":                          "CONVERTING %s",
+	"EXPLORADOR DE TEXTO UNICODE: ":           "UNICODE TEXT EXPLORER: ",
+	"SÓ ASCII: 1 BYTE POR RUNA":               "ASCII ONLY: 1 BYTE PER RUNE",
+	"ACENTO PRONTO: 1 RUNA DE 2 BYTES":        "PRECOMPOSED ACCENT: 1 RUNE OF 2 BYTES",
+	"ACENTO COMBINANTE: 2 RUNAS, 1 CARACTERE": "COMBINING ACCENT: 2 RUNES, 1 CHARACTER",
+	"EMOJI COM TOM DE PELE E BANDEIRA":        "EMOJI WITH SKIN TONE AND FLAG",
+	"BYTE INVÁLIDO NO MEIO DO TEXTO":          "INVALID BYTE IN THE MIDDLE OF THE TEXT",
+
+	// conversao
+	"não cabe no tipo":                  "does not fit in the type",
+	"a parte fracionária seria perdida": "the fractional part would be lost",
+	"não existe inteiro correspondente": "there is no matching integer",
+	"o valor seria arredondado":         "the value would be rounded",
+
+	// texto
+	"len = %d bytes, utf8.RuneCountInString = %d runas, %d caracteres visíveis\n":    "len = %d bytes, utf8.RuneCountInString = %d runes, %d visible characters\n",
+	"⚠ UTF-8 inválido em %d byte(s): o for range devolve %s (U+FFFD) para cada um\n": "⚠ invalid UTF-8 in %d byte(s): for range yields %s (U+FFFD) for each one\n",
+	"RUNA\tBYTE\tCARACTERE\tCÓDIGO\tUTF-8\tCATEGORIA":                                "RUNE\tBYTE\tCHARACTER\tCODE\tUTF-8\tCATEGORY",
+	"CARACTERES VISÍVEIS:":          "VISIBLE CHARACTERS:",
+	"%d: %s = %s (runas %d a %d)\n": "%d: %s = %s (runes %d to %d)\n",
+	"UTF-8 inválido":                "invalid UTF-8",
+	"letra maiúscula":               "uppercase letter",
+	"letra minúscula":               "lowercase letter",
+	"letra":                         "letter",
+	"marca combinante":              "combining mark",
+	"dígito":                        "digit",
+	"número":                        "number",
+	"pontuação":                     "punctuation",
+	"símbolo":                       "symbol",
+	"espaço":                        "space",
+	"controle":                      "control",
+	"formatação invisível":          "invisible formatting",
+
+	// notas
+	"o arquivo está vazio": "the file is empty",
+	"o cabeçalho precisa ter o nome e pelo menos uma coluna de notas": "the header needs the name and at least one grade column",
+	"linha %d, coluna %q: %v":                     "line %d, column %q: %v",
+	"nota vazia":                                  "empty grade",
+	"%q não é um número":                          "%q is not a number",
+	"nota %v fora de 0 a 10":                      "grade %v outside 0 to 10",
+	"nota %v não é um número finito":              "grade %v is not a finite number",
+	"%d pesos para %d avaliações":                 "%d weights for %d assessments",
+	"a soma dos pesos precisa ser maior que zero": "the weights must add up to more than zero",
+	"a média de recuperação (%v) é maior que a de aprovação (%v)": "the recovery average (%v) is greater than the pass average (%v)",
+	"peso negativo: %v":                       "negative weight: %v",
+	"peso %v não é um número finito":          "weight %v is not a finite number",
+	"a média de aprovação":                    "the pass average",
+	"a média de recuperação":                  "the recovery average",
+	"a média final":                           "the final average",
+	"%s (%v) precisa ser um número de 0 a 10": "%s (%v) must be a number from 0 to 10",
+	"arredondamento %q inválido (use %s)":     "invalid rounding %q (use %s)",
+	"aprovado":                                "passed",
+	"aprovado na recuperação":                 "passed in the recovery exam",
+	"em recuperação":                          "recovery exam pending",
+	"reprovado":                               "failed",
+	"TURMA: %d alunos\n":                      "CLASS: %d students\n",
+	"  aprovados: %d (%d na recuperação) · em recuperação: %d · reprovados: %d\n": "  passed: %d (%d in the recovery exam) · recovery pending: %d · failed: %d\n",
+	"  média da turma: %s · maior média: %s (%s) · menor média: %s (%s)\n":        "  class average: %s · highest average: %s (%s) · lowest average: %s (%s)\n",
+	"  média por avaliação: %s\n":                                                 "  average per assessment: %s\n",
+	"%s %s (peso %s)":                                                             "%s %s (weight %s)",
+	"  média: %s = %s":                                                            "  average: %s = %s",
+	", arredondada para %s (%s)":                                                  ", rounded to %s (%s)",
+	"décimo mais próximo":                                                         "nearest tenth",
+	"meio ponto mais próximo":                                                     "nearest half point",
+	"meio ponto acima":                                                            "next half point up",
+	"inteiro mais próximo":                                                        "nearest whole number",
+	"sem arredondamento":                                                          "no rounding",
+	"  recuperação: %s → média final (%s + %s) / 2 = %s\n":                        "  recovery exam: %s → final average (%s + %s) / 2 = %s\n",
+	"  recuperação: precisa de pelo menos %s para chegar a %s\n":                  "  recovery exam: needs at least %s to reach %s\n",
+	"  recuperação: nem 10 leva a média final a %s\n":                             "  recovery exam: not even 10 takes the final average to %s\n",
+	"  situação: %s\n":                                                            "  result: %s\n",
+
+	// decoradores
+	"← %s(%v): erro: %v (%v)\n": "← %s(%v): error: %v (%v)\n",
+	"fechado":                   "closed",
+	"aberto":                    "open",
+	"meio aberto":               "half-open",
+	"outro":                     "other",
+
+	// relogio
+	"velocidade %q inválida (use real, rapida ou instantanea)": "invalid speed %q (use real, fast or instant)",
+
+	// operadores
+	"OPERADORES ARITMETICOS: ":                                        "ARITHMETIC OPERATORS: ",
+	"OPERADORES RELACIONAIS: ":                                        "RELATIONAL OPERATORS: ",
+	"OPERADORES BIT A BIT: ":                                          "BITWISE OPERATORS: ",
+	"OPERADORES LOGICOS: ":                                            "LOGICAL OPERATORS: ",
+	"OPERADORES LOGICOS COM 3 COMBINACOES: ":                          "LOGICAL OPERATORS WITH 3 COMBINATIONS: ",
+	"OPERADOR && (AND) - Todas as combinações":                        "OPERATOR && (AND) - All combinations",
+	"OPERADOR || (OR) - Todas as combinações":                         "OPERATOR || (OR) - All combinations",
+	"OPERADORES LÓGICOS COM 3 COMBINAÇÕES":                            "LOGICAL OPERATORS WITH 3 COMBINATIONS",
+	"COMBINAÇÃO 1: true && true":                                      "COMBINATION 1: true && true",
+	"COMBINAÇÃO 2: true && false":                                     "COMBINATION 2: true && false",
+	"COMBINAÇÃO 3: false && true (CURTO-CIRCUITO)":                    "COMBINATION 3: false && true (SHORT-CIRCUIT)",
+	"COMBINAÇÃO 4: false && false (CURTO-CIRCUITO)":                   "COMBINATION 4: false && false (SHORT-CIRCUIT)",
+	"COMBINAÇÃO 1: true || true (CURTO-CIRCUITO)":                     "COMBINATION 1: true || true (SHORT-CIRCUIT)",
+	"COMBINAÇÃO 2: true || false (CURTO-CIRCUITO)":                    "COMBINATION 2: true || false (SHORT-CIRCUIT)",
+	"COMBINAÇÃO 3: false || true":                                     "COMBINATION 3: false || true",
+	"COMBINAÇÃO 4: false || false":                                    "COMBINATION 4: false || false",
+	"COMBINAÇÃO 1: (BLOCO1 && BLOCO2) && BLOCO3 = true":               "COMBINATION 1: (BLOCK1 && BLOCK2) && BLOCK3 = true",
+	"COMBINAÇÃO 2: (BLOCO1 && BLOCO2) && BLOCO3 = false":              "COMBINATION 2: (BLOCK1 && BLOCK2) && BLOCK3 = false",
+	"COMBINAÇÃO 3: (BLOCO1 || BLOCO2) && BLOCO3 = true":               "COMBINATION 3: (BLOCK1 || BLOCK2) && BLOCK3 = true",
+	"Avalia BLOCO 1 -> num1 > num2":                                   "Evaluate BLOCK 1 -> num1 > num2",
+	"BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10":               "BLOCK 1 is true, so evaluate BLOCK 2 -> num1 < 10",
+	"BLOCO 1 é false, então avalia BLOCO 2 -> num1 < 10":              "BLOCK 1 is false, so evaluate BLOCK 2 -> num1 < 10",
+	"BLOCO 1 é false, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)":      "BLOCK 1 is false, so BLOCK 2 is NOT evaluated (SHORT-CIRCUIT)",
+	"BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)":       "BLOCK 1 is true, so BLOCK 2 is NOT evaluated (SHORT-CIRCUIT)",
+	"BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO do ||)": "BLOCK 1 is true, so BLOCK 2 is NOT evaluated (|| SHORT-CIRCUIT)",
+	"(true) && BLOCO 3 -> num1 > num3":                                "(true) && BLOCK 3 -> num1 > num3",
+	"(false) && BLOCO 3 -> NÃO avalia BLOCO 3 (CURTO-CIRCUITO)":       "(false) && BLOCK 3 -> BLOCK 3 is NOT evaluated (SHORT-CIRCUIT)",
+	"BLOCO 1":                                        "BLOCK 1",
+	"BLOCO 1 && BLOCO 2":                             "BLOCK 1 && BLOCK 2",
+	"(não avaliado)":                                 "(not evaluated)",
+	"Executa o bloco if":                             "Runs the if block",
+	"NÃO executa o bloco if":                         "Does NOT run the if block",
+	"Esta mensagem não aparece":                      "This message does not appear",
+	"✅ num1 é maior que num2 E menor que 10":         "✅ num1 is greater than num2 AND less than 10",
+	"❌ num1 é maior que num2 MAS NÃO é menor que 10": "❌ num1 is greater than num2 BUT is NOT less than 10",
+	"❌ num1 NÃO é maior que num2 (BLOCO 2 não foi avaliado)":                      "❌ num1 is NOT greater than num2 (BLOCK 2 was not evaluated)",
+	"✅ num1 é maior que num2 OU menor que 10 (BLOCO 2 não foi avaliado)":          "✅ num1 is greater than num2 OR less than 10 (BLOCK 2 was not evaluated)",
+	"✅ num1 é maior que num2 (BLOCO 2 não foi avaliado)":                          "✅ num1 is greater than num2 (BLOCK 2 was not evaluated)",
+	"✅ num1 NÃO é maior que num2 MAS é menor que 10":                              "✅ num1 is NOT greater than num2 BUT is less than 10",
+	"❌ num1 NÃO é maior que num2 E NÃO é menor que 10":                            "❌ num1 is NOT greater than num2 AND is NOT less than 10",
+	"✅ Todas as condições são verdadeiras: num1 > num2 E num1 < 10 E num1 > num3": "✅ All conditions are true: num1 > num2 AND num1 < 10 AND num1 > num3",
+	"❌ BLOCO 1 && BLOCO 2 resultou em false, então BLOCO 3 não foi avaliado":      "❌ BLOCK 1 && BLOCK 2 resulted in false, so BLOCK 3 was not evaluated",
+	"✅ (num1 > num2 OU num1 < 10) E num1 > num3 - BLOCO 2 não foi avaliado":       "✅ (num1 > num2 OR num1 < 10) AND num1 > num3 - BLOCK 2 was not evaluated",
+	"PRECEDÊNCIA: * / % antes de + -":                                             "PRECEDENCE: * / % before + -",
+	"DIVISÃO E RESTO DE INTEIROS":                                                 "INTEGER DIVISION AND REMAINDER",
+	"OVERFLOW: O VALOR DÁ A VOLTA":                                                "OVERFLOW: THE VALUE WRAPS AROUND",
+	"FLOAT: ARREDONDAMENTO, INFINITO E %":                                         "FLOAT: ROUNDING, INFINITY AND %",
+	"OPERADORES RELACIONAIS: == != < <= > >=":                                     "RELATIONAL OPERATORS: == != < <= > >=",
+	"A MESMA COMPARAÇÃO EM TIPOS DIFERENTES":                                      "THE SAME COMPARISON IN DIFFERENT TYPES",
+	"OPERADORES BIT A BIT: & | ^ &^":                                              "BITWISE OPERATORS: & | ^ &^",
+	"DESLOCAMENTOS: << >>":                                                        "SHIFTS: << >>",
+	"%s em %s":                                                                    "%s in %s",
+	"OPERADORES LOGICOS EM QUALQUER EXPRESSAO: ":                                  "LOGICAL OPERATORS IN ANY EXPRESSION: ",
+	"EXPRESSÃO: %s":    "EXPRESSION: %s",
+	"CENÁRIO %d":       "SCENARIO %d",
+	"TABELA VERDADE: ": "TRUTH TABLE: ",
+
+	// expressao
+	"Avalia %s": "Evaluate %s",
+	"%s é %v, então NÃO avalia %s (CURTO-CIRCUITO)":           "%s is %v, so %s is NOT evaluated (SHORT-CIRCUIT)",
+	"TAUTOLOGIA (true em todas as linhas)":                    "TAUTOLOGY (true in every row)",
+	"CONTRADIÇÃO (false em todas as linhas)":                  "CONTRADICTION (false in every row)",
+	"CONTINGÊNCIA (true em algumas linhas e false em outras)": "CONTINGENCY (true in some rows and false in others)",
+	"não avaliado":       "not evaluated",
+	"não avaliado em %s": "not evaluated in %s",
+	"EQUIVALENTES: as expressões dão o mesmo resultado em todas as linhas":           "EQUIVALENT: the expressions give the same result in every row",
+	"NÃO EQUIVALENTES: os resultados diferem em %d de %d linhas, por exemplo com %s": "NOT EQUIVALENT: the results differ in %d of %d rows, for example with %s",
+	"%q: use nome=valor (ex.: a=7 ou d=false)":                                       "%q: use name=value (e.g. a=7 or d=false)",
+	"valor %q não é int nem bool":                                                    "value %q is neither int nor bool",
+	"%s resulta em %T, esperava bool":                                                "%s results in %T, expected bool",
+	"a variável %s não tem valor":                                                    "the variable %s has no value",
+	"%s: o operador %s precisa de bool, mas o valor é %T":                            "%s: the %s operator needs bool, but the value is %T",
+	"não é possível comparar int com %T":                                             "cannot compare int with %T",
+	"não é possível comparar bool com %T":                                            "cannot compare bool with %T",
+	"o operador %s não funciona com bool":                                            "the %s operator does not work with bool",
+	"tipo %T não suportado":                                                          "type %T is not supported",
+	"posição %d: %s":                                                                 "position %d: %s",
+	"use == para comparar":                                                           "use == to compare",
+	"use && para o E lógico":                                                         "use && for logical AND",
+	"use || para o OU lógico":                                                        "use || for logical OR",
+	"caractere %q inesperado":                                                        "unexpected character %q",
+	"%q inesperado depois da expressão":                                              "unexpected %q after the expression",
+	"esperava um número depois de -":                                                 "expected a number after -",
+	"esperava )":                                                                     "expected )",
+	"a expressão terminou antes do esperado":                                         "the expression ended too early",
+	"%q inesperado":                                                                  "unexpected %q",
+	"número %s muito grande":                                                         "number %s is too large",
+	"informe ao menos uma expressão":                                                 "give at least one expression",
+	"%d variáveis geram linhas demais; o máximo é %d":                                "%d variables generate too many rows; the maximum is %d",
+
+	// calculadora
+	"Calcula %s":         "Calculate %s",
+	"Guarda %s em um %s": "Store %s in a %s",
+	"%s não tem números negativos: -%v dá a volta e vira %v":                                              "%s has no negative numbers: -%v wraps around to %v",
+	"overflow: o resultado exato %s não cabe em %s (de %s a %s) e dá a volta para %v":                     "overflow: the exact result %s does not fit in %s (%s to %s) and wraps around to %v",
+	"divisão por zero: com inteiros o programa para com panic (runtime error: integer divide by zero)":    "division by zero: with integers the program stops with a panic (runtime error: integer divide by zero)",
+	"divisão inteira: %v / %v = %s, mas a parte fracionária é descartada (trunca em direção a zero)":      "integer division: %v / %v = %s, but the fractional part is discarded (truncated toward zero)",
+	"o resto tem o sinal do dividendo: %v = %v * %v + (%v)":                                               "the remainder has the sign of the dividend: %v = %v * %v + (%v)",
+	"%s não tem representação exata em %s; o valor guardado é %s":                                         "%s has no exact representation in %s; the stored value is %s",
+	"o operador %% não existe para %s (use math.Mod)":                                                     "the %% operator does not exist for %s (use math.Mod)",
+	"o operador %s só existe para inteiros, e %s é ponto flutuante":                                       "the %s operator only exists for integers, and %s is floating point",
+	"TIPO\tBYTES\tMÍNIMO\tMÁXIMO\tZERO":                                                                   "TYPE\tBYTES\tMINIMUM\tMAXIMUM\tZERO",
+	"v é um %s e vale %v":                                                                                 "v is a %s holding %v",
+	"Converte a constante":                                                                                "Converts the constant",
+	"Converte a variável":                                                                                 "Converts the variable",
+	"não compila":                                                                                         "does not compile",
+	"indefinido":                                                                                          "undefined",
+	"v := %s não compila: a constante estoura int (constant overflows int)":                               "v := %s does not compile: the constant overflows int (constant overflows int)",
+	"v := %s não compila: %s não é um literal numérico do Go":                                             "v := %s does not compile: %s is not a Go numeric literal",
+	"%s não é um número":                                                                                  "%s is not a number",
+	"%s não é inteiro e não pode ser guardado em %s (o compilador recusa: constant truncated to integer)": "%s is not an integer and cannot be stored in %s (the compiler rejects it: constant truncated to integer)",
+	"a constante %s estoura %s, que vai de %s a %s (o compilador recusa: constant overflows %s)":          "the constant %s overflows %s, which goes from %s to %s (the compiler rejects it: constant overflows %s)",
+	"a constante %s estoura %s (o maior valor é %v)":                                                      "the constant %s overflows %s (the largest value is %v)",
+	"v := %s não compila: a constante estoura float64 (constant overflows float64)":                       "v := %s does not compile: the constant overflows float64 (constant overflows float64)",
+	"%v não cabe em %s (de %s a %s): os bits que sobram são descartados e o valor dá a volta para %v":     "%v does not fit in %s (from %s to %s): the extra bits are discarded and the value wraps around to %v",
+	"%v está fora da faixa de %s (de %s a %s): a especificação do Go não define o resultado, que muda de uma plataforma para outra": "%v is out of the range of %s (from %s to %s): the Go specification leaves the result undefined, and it changes from one platform to another",
+	"a parte fracionária é descartada (trunca em direção a zero): %v vira %v":                                                       "the fractional part is discarded (truncates toward zero): %v becomes %v",
+	"overflow: %s passa do maior %s e vira %v":                                                                                      "overflow: %s is past the largest %s and becomes %v",
+	"arredondamento: %s não cabe exatamente em %s e vira o valor mais próximo, %s":                                                  "rounding: %s does not fit exactly in %s and becomes the nearest value, %s",
+	"deslocamento negativo: o programa para com panic (runtime error: negative shift amount)":                                       "negative shift: the program stops with a panic (runtime error: negative shift amount)",
+	"%s tem sinal: >> repete o bit de sinal à esquerda (extensão de sinal), então %v >> %v = %v continua negativo":                  "%s is signed: >> copies the sign bit in from the left (sign extension), so %v >> %v = %v stays negative",
+	"deslocar %d ou mais posições tira todos os bits de um %s: o resultado é %v":                                                    "shifting by %d or more positions pushes every bit out of a %s: the result is %v",
+	"os bits que passam dos %d de %s são descartados: o resultado exato %s vira %v":                                                 "the bits beyond the %d of %s are discarded: the exact result %s becomes %v",
+	"divisão de float por zero não causa panic: o resultado é %v":                                                                   "float division by zero does not panic: the result is %v",
+	"overflow: o resultado passa do maior %s e vira %v":                                                                             "overflow: the result goes past the largest %s and becomes %v",
+	"arredondamento: o resultado exato %s não cabe em %s e vira o valor mais próximo, %s":                                           "rounding: the exact result %s does not fit in %s and becomes the nearest value, %s",
+	"tipo %q inválido (use %s)":                       "invalid type %q (use %s)",
+	"%s é bool e o operador %s precisa de %s":         "%s is bool and the %s operator needs %s",
+	"operador %s não é aritmético":                    "operator %s is not arithmetic",
+	"a calculadora só aceita números (sem variáveis)": "the calculator only accepts numbers (no variables)",
+	"%q inesperado depois da conta":                   "unexpected %q after the calculation",
+	"a conta terminou antes do esperado":              "the calculation ended too early",
+
+	// ifelse
+	"Você é maior de idade": "You are an adult",
+	"Você é menor de idade": "You are a minor",
+
+	// switchs
+	"O numero é 1":                     "The number is 1",
+	"O numero é 2":                     "The number is 2",
+	"O numero é 3":                     "The number is 3",
+	"O numero é diferente de 1, 2 e 3": "The number is not 1, 2 or 3",
+	"Domingo":                          "Sunday",
+	"Segunda-feira":                    "Monday",
+	"Terça-feira":                      "Tuesday",
+	"Quarta-feira":                     "Wednesday",
+	"Quinta-feira":                     "Thursday",
+	"Sexta-feira":                      "Friday",
+	"Sábado":                           "Saturday",
+	"Dia inválido":                     "Invalid day",
+
+	// loops
+	"Loop For Range: O valor do indice é: ":        "Loop For Range: The index is: ",
+	"Loop For Range: O valor do valor é: ":         "Loop For Range: The value is: ",
+	"Loop For Range String: O valor do indice é: ": "Loop For Range String: The index is: ",
+	"Loop For Range String: O valor do valor é: ":  "Loop For Range String: The value is: ",
+	"Loop For Range Map: O valor da chave é: ":     "Loop For Range Map: The key is: ",
+	"Loop For Range Map: O valor do valor é: ":     "Loop For Range Map: The value is: ",
+
+	// structs
+	"Usuario: %+v\n":  "User: %+v\n",
+	"Usuario2: %+v\n": "User2: %+v\n",
+
+	// heranca
+	"Herança": "Inheritance",
+
+	// slice
+	"Append multiplos:":                                       "Append multiple:",
+	"Atribuindo array a slice:":                               "Assigning array to slice:",
+	"Atribuindo array a slice pelo indice 1 até 3:":           "Assigning array to slice from index 1 to 3:",
+	"Removendo item por indice:":                              "Removing item by index:",
+	"Tamanho do slice:":                                       "Slice length:",
+	"Capacidade do slice:":                                    "Slice capacity:",
+	"Criando slice com make:":                                 "Creating slice with make:",
+	"Criando slice com make vazio com capacidade inicial:":    "Creating empty slice with make and initial capacity:",
+	"Criando slice com make preenchido com zeros:":            "Creating slice with make filled with zeros:",
+	"Criando slice com make tamanho e capacidade diferentes:": "Creating slice with make with different length and capacity:",
+
+	// ponteiro
+	"Valor da variavel1:":                                     "Value of variavel1:",
+	"Valor da variavel2:":                                     "Value of variavel2:",
+	"Valor do ponteiro:":                                      "Value of the pointer:",
+	"Endereço do ponteiro:":                                   "Pointer address:",
+	"DiferencaEntrePonteiroEValor - Valor da variavel1:":      "DiferencaEntrePonteiroEValor - Value of variavel1:",
+	"ModificarValorApontadoPorPonteiro - Valor da variavel1:": "ModificarValorApontadoPorPonteiro - Value of variavel1:",
+
+	// funcoes
+	"FUNÇÃO COM RETORNO:":                                        "FUNCTION WITH RETURN:",
+	"REUPERANDO VALOR DA FUNÇÃO COM VARIAVEL:":                   "GETTING THE FUNCTION VALUE INTO A VARIABLE:",
+	"FUNÇÃO SEM RETORNO: ":                                       "FUNCTION WITHOUT RETURN: ",
+	"PASSANDO FUNÇÃO PARA VARIAVEL:":                             "ASSIGNING A FUNCTION TO A VARIABLE:",
+	"FUNÇÃO COM MAIS DE UM RETORNO:":                             "FUNCTION WITH MORE THAN ONE RETURN:",
+	"FUNÇÃO COM MAIS DE UM RETORNO - IGNORANDO SEGUNDO RETORNO:": "FUNCTION WITH MORE THAN ONE RETURN - IGNORING THE SECOND RETURN:",
+	"FUNÇÃO COM RETORNO NOMEADO - Soma:":                         "FUNCTION WITH NAMED RETURN - Sum:",
+	"Subtração:":                                                 "Subtraction:",
+	"FUNÇÃO RECURSIVA:":                                          "RECURSIVE FUNCTION:",
+	"FIBONACCI SEM REPETIR CONTAS: ":                             "FIBONACCI WITHOUT REPEATING WORK: ",
+	"n\tF(n)\tCHAMADAS RECURSIVA\tCHAMADAS MEMORIZADO\tSOMAS ITERATIVO\tPASSOS DUPLICAÇÃO\t":                                     "n\tF(n)\tRECURSIVE CALLS\tMEMOIZED CALLS\tITERATIVE ADDITIONS\tDOUBLING STEPS\t",
+	"A versão recursiva calcula as mesmas posições de novo e de novo: F(n) chama F(n-1) e F(n-2), que chamam F(n-2) e F(n-3)...": "The recursive version computes the same positions over and over: F(n) calls F(n-1) and F(n-2), which call F(n-2) and F(n-3)...",
+	"As chamadas crescem como o próprio F(n) (2·F(n+1) − 1), cerca de 1,6 vezes a cada posição.":                                 "The calls grow like F(n) itself (2·F(n+1) − 1), about 1.6 times per position.",
+	"F(%d) com int: %d, com big.Int: %s\n":                                                 "F(%d) with int: %d, with big.Int: %s\n",
+	"F(93) passa do maior int (9223372036854775807) e dá a volta para um número negativo.": "F(93) is past the largest int (9223372036854775807) and wraps around to a negative number.",
+	"F(1000) tem %d algarismos e a duplicação chega nele em %d passos.\n":                  "F(1000) has %d digits and doubling reaches it in %d steps.\n",
+	"RECURSÃO RASTREADA COM DEFER: ":                                                       "RECURSION TRACED WITH DEFER: ",
+	" erro: %v":                                                                            " error: %v",
+	"%d chamadas, profundidade máxima %d\n":                                                "%d calls, maximum depth %d\n",
+	"FUNÇÃO VARIÁTICA GENÉRICA: ":                                                          "GENERIC VARIADIC FUNCTION: ",
+	"Somar com ints:":                                                                      "Somar with ints:",
+	"Somar com float64:":                                                                   "Somar with float64:",
+	"Somar com um slice (notas...):":                                                       "Somar with a slice (notas...):",
+	"Somar[uint8](200, 100) estoura como o uint8:":                                         "Somar[uint8](200, 100) overflows like uint8:",
+	"Maximo com strings:":                                                                  "Maximo with strings:",
+	"Minimo() sem valores não compila: o primeiro parâmetro não é variádico.":              "Minimo() with no values does not compile: the first parameter is not variadic.",
+	"Parear nomes e notas:":                                                                "Parear (zip) names and grades:",
+	"Particionar por nota >= 6:":                                                           "Particionar (partition) by grade >= 6:",
+	"Média dos aprovados: %.2f\n":                                                          "Average of the students who passed: %.2f\n",
+	"Filtrar notas acima de 7:":                                                            "Filtrar (filter) grades above 7:",
+	"Reduzir os nomes às iniciais:":                                                        "Reduzir (reduce) the names to their initials:",
+	"Agrupar os nomes pelo tamanho:":                                                       "Agrupar (group) the names by length:",
+	"Último nome em ordem alfabética:":                                                     "Last name in alphabetical order:",
+	"FUNÇÃO COM ERRO EM VEZ DE PANIC: ":                                                    "FUNCTION WITH AN ERROR INSTEAD OF PANIC: ",
+	"média %.2f das notas %v é menor que %v":                                               "average %.2f of grades %v is below %v",
+	"a nota %v (posição %d) está fora de 0 a 10":                                           "grade %v (position %d) is outside 0 to 10",
+	"nenhuma nota informada":                                                               "no grades given",
+	"panic em %s: %v":                                                                      "panic in %s: %v",
+	"Com panic e recover (FuncaoPanic(5, 4)):":                                             "With panic and recover (FuncaoPanic(5, 4)):",
+	"Com erro (VerificarAprovacao):":                                                       "With an error (VerificarAprovacao):",
+	"%v: aprovado com média %.2f\n":                                                        "%v: passed with average %.2f\n",
+	"%v: erro: %v\n":                                                                       "%v: error: %v\n",
+	"   errors.Is(err, ErrMediaInsuficiente) e errors.As: faltam %.2f pontos na média\n": "   errors.Is(err, ErrMediaInsuficiente) and errors.As: the average is %.2f points short\n",
+	"   errors.Is(err, ErrNotaInvalida) e errors.As: corrija a nota da posição %d\n":     "   errors.Is(err, ErrNotaInvalida) and errors.As: fix the grade at position %d\n",
+	"   errors.Is(err, ErrSemNotas): nada para calcular":                                 "   errors.Is(err, ErrSemNotas): nothing to calculate",
+	"boletim de %s: %w":                          "report card of %s: %w",
+	"Com mais contexto (fmt.Errorf e %w):":       "With more context (fmt.Errorf and %w):",
+	"   errors.Is continua encontrando a causa:": "   errors.Is still finds the cause:",
+	"De panic para erro (CapturarPanic):":        "From panic to error (CapturarPanic):",
+	"   errors.As(err, &ErroPanic): a pilha completa fica em Pilha e o valor do panic em Valor": "   errors.As(err, &ErroPanic): the full stack is in Pilha and the panic value in Valor",
+	"   errors.Is(err, ErrMediaInsuficiente):":                                                  "   errors.Is(err, ErrMediaInsuficiente):",
+	"BOLETIM DA TURMA: ": "CLASS REPORT CARD: ",
+	"Pesos 3, 3 e 4; aprovado com 6; recuperação a partir de 4, com média final 5:": "Weights 3, 3 and 4; pass with 6; recovery exam from 4, with final average 5:",
+	"FUNÇÃO DECORADA: ":                 "DECORATED FUNCTION: ",
+	"serviço indisponível (chamada %d)": "service unavailable (call %d)",
+	"Retentar (3 tentativas, espera de 100ms dobrando) por fora de Registrar, que mostra cada tentativa:": "Retentar (3 attempts, 100ms wait doubling) outside Registrar, which shows each attempt:",
+	"Esperas entre as tentativas:": "Waits between attempts:",
+	"LimitarTempo (10ms) numa função que só para quando o contexto é cancelado:": "LimitarTempo (10ms) on a function that only stops when the context is cancelled:",
+	"Erro:": "Error:",
+	"Memorizar: a segunda chamada com a mesma entrada não chama a função:": "Memorizar: the second call with the same input does not call the function:",
+	"3 chamadas, %d cálculos\n":                             "3 calls, %d computations\n",
+	"Disjuntor (abre com 2 falhas seguidas, pausa de 30s):": "Disjuntor (circuit breaker; opens after 2 failures in a row, 30s pause):",
+	"serviço fora do ar":                                    "service down",
+	"  erro: %v → disjuntor %v\n":                           "  error: %v → breaker %v\n",
+	"  nota %v → disjuntor %v\n":                            "  grade %v → breaker %v\n",
+	"  30s depois, o serviço voltou e o disjuntor está":     "  30s later, the service is back and the breaker is",
+	"CLOSURES COM ESTADO: ":                                 "CLOSURES WITH STATE: ",
+	"Contador: cada chamada de Contador() cria um total novo, capturado pelas funções que ela devolve": "Contador: each call to Contador() creates a new total, captured by the functions it returns",
+	"  contador A: %d, contador B: %d\n":                                     "  counter A: %d, counter B: %d\n",
+	"  depois de 1000 goroutines incrementando A: %d\n":                      "  after 1000 goroutines incrementing A: %d\n",
+	"GeradorDeIDs: o próximo número fica capturado e protegido por um mutex": "GeradorDeIDs: the next number is captured and guarded by a mutex",
+	"LimitadorDeTaxa: balde com 3 fichas e recarga de 2 por segundo":         "LimitadorDeTaxa: bucket with 3 tokens refilled at 2 per second",
+	"aceita":   "allowed",
+	"recusada": "rejected",
+	"UmaVez: a configuração é carregada na primeira chamada e reaproveitada nas outras": "UmaVez: the configuration is loaded on the first call and reused on the others",
+	"  idioma %s, cargas: %d\n":                  "  language %s, loads: %d\n",
+	"Aluno aprovado:":                            "Student passed:",
+	"Dentro da main":                             "Inside main",
+	"Numero:":                                    "Number:",
+	"Funcao com retorno: %d + %d = %d":           "Function with return: %d + %d = %d",
+	"Funcao sem retorno":                         "Function without return",
+	"Passando funcao para variavel":              "Assigning function to variable",
+	"Funcao com Defer":                           "Function with Defer",
+	"Funcao Sem Defer":                           "Function without Defer",
+	"Fim da lição: só agora o defer é executado": "End of the lesson: only now the defer runs",
+	"Media calculada. Resultado será retornado":  "Average calculated. The result will be returned",
+	"Calculando media...":                        "Calculating average...",
+	"Recuperado de panic:":                       "Recovered from panic:",
+	"Media menor que 6":                          "Average below 6",
+	"Dentro da funcao closure":                   "Inside the closure function",
+	"Funcao Init":                                "Init Function",
+
+	// metodos e json
+	"Salvando usuario: ":     "Saving user: ",
+	"Senha: ":                "Password: ",
+	"Idade: ":                "Age: ",
+	"Erro ao fazer Marshal:": "Error running Marshal:",
+
+	// interfaces
+	"A area da forma é %0.2f":                             "The area of the shape is %0.2f",
+	"FORMAS: ":                                            "SHAPES: ",
+	"FORMA\tÁREA\tPERÍMETRO\tCAIXA":                       "SHAPE\tAREA\tPERIMETER\tBOX",
+	"%s\tinválida: %v\n":                                  "%s\tinvalid: %v\n",
+	"Formas recusadas por Validar:":                       "Shapes rejected by Validar:",
+	"não pode ser negativo":                               "cannot be negative",
+	"precisa ser um número finito":                        "must be a finite number",
+	"um polígono precisa de pelo menos 3 vértices":        "a polygon needs at least 3 vertices",
+	"os vértices estão alinhados e não formam uma figura": "the vertices are collinear and do not form a shape",
+
+	// aplicacao_linha_comando
+	"Busca Ips e Nomes de Servidor na internet":                                   "Looks up IPs and server names on the internet",
+	"Aluno dono do progresso (padrao: usuario do sistema)":                        "Student who owns the progress (default: system user)",
+	"Idioma das licoes e da ajuda: pt-BR ou en (padrao: LANG)":                    "Language of the lessons and help: pt-BR or en (default: LANG)",
+	"Busca Ips de endereco na internet":                                           "Looks up the IPs of an address on the internet",
+	"Busca o nome do servidor na internet":                                        "Looks up the name servers of an address on the internet",
+	"informe uma ou duas expressoes (ex.: \"!(a && b)\" \"!a || !b\")":            "give one or two expressions (e.g. \"!(a && b)\" \"!a || !b\")",
+	"informe a expressao (ex.: \"a > b && (c < 10 || !d)\" a=7 b=5 c=12 d=false)": "give the expression (e.g. \"a > b && (c < 10 || !d)\" a=7 b=5 c=12 d=false)",
+	"informe o topico (ex.: slice, ponteiro)":                                     "give the topic (e.g. slice, ponteiro)",
+	"topico %q nao existe":                                                        "topic %q does not exist",
+	"erro ao ler %s: %v":                                                          "error reading %s: %v",
+	"informe o texto entre aspas (ex.: texto \"café\")":                           "give the text in quotes (e.g. texto \"café\")",
+	"escape invalido no texto (use escapes do Go, como \\xff ou \\u00e9)":         "invalid escape in the text (use Go escapes, like \\xff or \\u00e9)",
+	"informe no maximo um literal (ex.: tipos 300)":                               "give at most one literal (e.g. tipos 300)",
+	"Pratica os topicos do curso com exercicios corrigidos automaticamente":       "Practice the course topics with automatically graded exercises",
+	"Lista os exercicios disponiveis":                                             "Lists the available exercises",
+	"Cria o arquivo <nome>.go com a assinatura da funcao a ser implementada":      "Creates the file <name>.go with the signature of the function to implement",
+	"<nome>": "<name>",
+	"Compila e executa a solucao contra os casos escondidos do exercicio": "Compiles and runs the solution against the exercise's hidden cases",
+	"<nome> [arquivo.go]": "<name> [file.go]",
+	"Mostra as licoes vistas, os exercicios resolvidos e os placares do quiz por topico": "Shows the lessons viewed, exercises solved and quiz scores per topic",
+	"Executa as licoes de um topico e marca como vistas no progresso":                    "Runs the lessons of a topic and marks them as viewed in the progress",
+	"Como mostrar os passos das licoes: texto, cores ou json":                            "How to show the lesson steps: texto (plain), cores (colours) or json",
+	"<topico>": "<topic>",
+	"Avalia uma expressao booleana passo a passo, mostrando o curto-circuito":                                     "Evaluates a boolean expression step by step, showing the short-circuit",
+	"\"<expressao>\" [nome=valor ...]":                                                                            "\"<expression>\" [name=value ...]",
+	"Mostra a tabela verdade de uma expressao; com duas, diz se sao equivalentes":                                 "Shows the truth table of an expression; with two, tells whether they are equivalent",
+	"\"<expressao>\" [\"<outra expressao>\"]":                                                                     "\"<expression>\" [\"<another expression>\"]",
+	"Faz uma conta em um tipo numerico do Go, mostrando overflow, divisao inteira e arredondamento":               "Calculates in a Go numeric type, showing overflow, integer division and rounding",
+	"Faz uma conta em um tipo inteiro do Go, mostrando cada operacao em binario e hexadecimal":                    "Calculates in a Go integer type, showing every operation in binary and hexadecimal",
+	"Mostra tamanho, limites e valor zero dos tipos numericos; com um literal, mostra a conversao para cada tipo": "Shows size, limits and zero value of the numeric types; with a literal, shows the conversion to each type",
+	"[literal]": "[literal]",
+	"Tipo a mostrar (pode repetir; padrao: todos): ":                          "Type to show (repeatable; default: all): ",
+	"Mostra bytes, runas, codificacao UTF-8 e categorias Unicode de um texto": "Shows bytes, runes, UTF-8 encoding and Unicode categories of a text",
+	"\"<texto>\"": "\"<text>\"",
+	"Interpreta escapes do Go no texto, como \\xff, \\u0301 e \\n":                                                 "Interprets Go escapes in the text, such as \\xff, \\u0301 and \\n",
+	"Le um CSV de notas e mostra o boletim de cada aluno e as estatisticas da turma":                               "Reads a CSV of grades and shows each student's report card and the class statistics",
+	"<arquivo.csv ou - para a entrada padrao>":                                                                     "<file.csv or - for standard input>",
+	"Peso de cada avaliacao, separados por virgula (ex.: 3,3,4; padrao: todos iguais)":                             "Weight of each assessment, separated by commas (e.g. 3,3,4; default: all equal)",
+	"Media para aprovar sem recuperacao":                                                                           "Average needed to pass without a recovery exam",
+	"Media minima para ter direito a recuperacao":                                                                  "Minimum average to take the recovery exam",
+	"Media final exigida depois da recuperacao":                                                                    "Final average required after the recovery exam",
+	"informe o arquivo CSV (ex.: notas turma.csv) ou - para ler da entrada padrao":                                 "give the CSV file (e.g. notas turma.csv) or - to read from standard input",
+	"peso %q invalido (ex.: --pesos 3,3,4)":                                                                        "invalid weight %q (e.g. --pesos 3,3,4)",
+	"Como arredondar as medias: ":                                                                                  "How to round the averages: ",
+	"[--] \"<conta>\"":                                                                                             "[--] \"<calculation>\"",
+	"a conta começa com - e foi lida como uma opção; escreva -- antes dela (ex.: %s --tipo int8 -- \"-128 / -1\")": "the calculation starts with - and was read as an option; write -- before it (e.g. %s --tipo int8 -- \"-128 / -1\")",
+	"Uso incorreto: %v\n\n":                                                                                        "Incorrect usage: %v\n\n",
+	"informe a conta entre aspas (ex.: %s --tipo int8 \"127 + 1\")":                                                "give the calculation in quotes (e.g. %s --tipo int8 \"127 + 1\")",
+	"tipo %q invalido (use %s)":                                                                                    "invalid type %q (use %s)",
+	"Tipo usado na conta: ":                                                                                        "Type used in the calculation: ",
+	"Como mostrar os passos: texto, cores ou json":                                                                 "How to show the steps: texto (plain), cores (colours) or json",
+	"Perguntas de multipla escolha e de prever a saida das licoes do curso":                                        "Multiple choice and predict-the-output questions about the course lessons",
+	"Faz apenas as perguntas de um topico (ex.: slice, ponteiro)":                                                  "Asks only the questions of one topic (e.g. slice, ponteiro)",
+	"Velocidade das licoes que esperam (ex.: loops): real, rapida ou instantanea":                                  "Speed of lessons that wait (e.g. loops): real, rapida (fast) or instantanea (instant)",
+	"nao ha perguntas para o topico %q":                                                                            "there are no questions for topic %q",
+	"informe o nome do exercicio (veja 'exercicio listar')":                                                        "give the exercise name (see 'exercicio listar')",
+	"exercicio %q nao existe (veja 'exercicio listar')":                                                            "exercise %q does not exist (see 'exercicio listar')",
+	"o arquivo %s ja existe":                                                                                       "the file %s already exists",
+	"Arquivo criado:":                                                                                              "File created:",
+
+	// exercicios
+	"package solucao\n\n// %s\n%s {\n\t// TODO: escreva sua solução aqui\n\tpanic(\"não implementado\")\n}\n": "package solucao\n\n// %s\n%s {\n\t// TODO: write your solution here\n\tpanic(\"not implemented\")\n}\n",
+	"Exercício:":                                    "Exercise:",
+	"✅ caso %d passou\n":                            "✅ case %d passed\n",
+	"❌ caso %d falhou com panic: %s\n":              "❌ case %d failed with panic: %s\n",
+	"❌ caso %d falhou, valor obtido: %s\n":          "❌ case %d failed, value obtained: %s\n",
+	"   Dica:":                                      "   Hint:",
+	"Resultado: %d/%d casos passaram\n":             "Result: %d/%d cases passed\n",
+	"erro de sintaxe na solução: %w":                "syntax error in the solution: %w",
+	"a solução demorou mais de %s (loop infinito?)": "the solution took more than %s (infinite loop?)",
+	"a solução não compilou:\n%s":                   "the solution did not compile:\n%s",
+	"o verificador não gravou o resultado (a solução chamou os.Exit?): %w":                    "the checker did not write the result (did the solution call os.Exit?): %w",
+	"saída inesperada do verificador: %w":                                                     "unexpected output from the checker: %w",
+	"assinatura inválida no exercício %s: %w":                                                 "invalid signature in exercise %s: %w",
+	"a função %s deve ter a assinatura %q, mas foi declarada como %q":                         "the function %s must have the signature %q, but was declared as %q",
+	"a função %s não foi encontrada na solução (assinatura esperada: %s)":                     "the function %s was not found in the solution (expected signature: %s)",
+	"Some apenas os números pares de 1 até n (inclusive).":                                    "Add up only the even numbers from 1 to n (inclusive).",
+	"Remova o item do índice i e devolva o novo slice.":                                       "Remove the item at index i and return the new slice.",
+	"Devolva um novo slice com os itens em ordem inversa, sem alterar o original.":            "Return a new slice with the items in reverse order, without changing the original.",
+	"Conte quantas vezes cada palavra aparece no slice.":                                      "Count how many times each word appears in the slice.",
+	"Troque chaves por valores (linguagem -> ano vira ano -> linguagem).":                     "Swap keys and values (language -> year becomes year -> language).",
+	"Dobre o valor da variável apontada pelo ponteiro.":                                       "Double the value of the variable the pointer points to.",
+	"Troque os valores das duas variáveis apontadas.":                                         "Swap the values of the two variables pointed to.",
+	"Some as áreas de todas as formas recebidas (qualquer tipo com o método Area() float64).": "Add up the areas of all the shapes received (any type with the method Area() float64).",
+	"Use o operador % para saber se o número é par.":                                          "Use the % operator to find out whether the number is even.",
+	"1 é ímpar, então não entra na soma.":                                                     "1 is odd, so it is not part of the sum.",
+	"Quando n é 0 o loop nem deve executar.":                                                  "When n is 0 the loop should not even run.",
+	"O limite n também precisa ser considerado (use <=).":                                     "The limit n also has to be included (use <=).",
+	"Use append(numeros[:i], numeros[i+1:]...) como em slice.RemoverItemPorIndice.":           "Use append(numeros[:i], numeros[i+1:]...) as in slice.RemoverItemPorIndice.",
+	"Remover o primeiro item é o mesmo que numeros[1:].":                                      "Removing the first item is the same as numeros[1:].",
+	"Remover o último item não pode acessar um índice fora do slice.":                         "Removing the last item must not access an index outside the slice.",
+	"Um slice com um item vira um slice vazio (não nil).":                                     "A slice with one item becomes an empty slice (not nil).",
+	"Percorra o slice do último índice (len-1) até o 0.":                                      "Walk the slice from the last index (len-1) down to 0.",
+	"Crie o resultado com make([]string, len(textos)).":                                       "Create the result with make([]string, len(textos)).",
+	"Não altere o slice recebido: slices compartilham o mesmo array por baixo.":               "Do not change the slice received: slices share the same underlying array.",
+	"Use contagem[palavra]++: o zero value de int é 0.":                                       "Use contagem[palavra]++: the zero value of int is 0.",
+	"Devolva um map vazio criado com make, e não um map nil.":                                 "Return an empty map created with make, not a nil map.",
+	"As chaves de um map diferenciam maiúsculas de minúsculas.":                               "Map keys are case-sensitive.",
+	"Percorra com for chave, valor := range dados e grave resultado[valor] = chave.":          "Loop with for chave, valor := range dados and store resultado[valor] = chave.",
+	"Crie o map de resultado com make antes de gravar nele.":                                  "Create the result map with make before writing to it.",
+	"Altere o valor apontado com *numero = ..., e não o ponteiro.":                            "Change the value pointed to with *numero = ..., not the pointer.",
+	"Números negativos também devem ser dobrados.":                                            "Negative numbers must be doubled too.",
+	"Verifique se o ponteiro é nil antes de usar *numero.":                                    "Check whether the pointer is nil before using *numero.",
+	"Em Go é possível fazer *a, *b = *b, *a.":                                                 "In Go you can write *a, *b = *b, *a.",
+	"Os dois ponteiros podem apontar para a mesma variável.":                                  "The two pointers may point to the same variable.",
+	"Percorra as formas e acumule forma.Area().":                                              "Loop over the shapes and add up forma.Area().",
+	"Sem formas a soma é zero.":                                                               "With no shapes the sum is zero.",
+
+	// quiz
+	"lição %s/%s não encontrada": "lesson %s/%s not found",
+	"\nPergunta %d/%d [%s]\n":    "\nQuestion %d/%d [%s]\n",
+	"✅ Correto!":                 "✅ Correct!",
+	"❌ Resposta correta:":        "❌ Correct answer:",
+	"--- saída de %s.%s ---\n":   "--- output of %s.%s ---\n",
+	"\nPlacar: %d/%d\n":          "\nScore: %d/%d\n",
+	"O que array.InicializacaoComIndicesEspecificos imprime para [5]string{1: \"Sophia\", 3: \"Eduardo\"}?":                       "What does array.InicializacaoComIndicesEspecificos print for [5]string{1: \"Sophia\", 3: \"Eduardo\"}?",
+	"Os índices não informados recebem o zero value de string (\"\"), que o fmt imprime como vazio entre os espaços.":             "The indices that are not given get the zero value of string (\"\"), which fmt prints as nothing between the spaces.",
+	"Preveja a saída: qual slice slice.AppendMultiplos imprime depois de append(slice, 6, 7, 8, 9, 10)?":                          "Predict the output: which slice does slice.AppendMultiplos print after append(slice, 6, 7, 8, 9, 10)?",
+	"append aceita vários valores de uma vez e devolve o slice com todos eles no final.":                                          "append accepts several values at once and returns the slice with all of them at the end.",
+	"Depois de append(slice[:2], slice[3:]...) em um slice de 5 itens, qual capacidade (cap) slice.RemoverItemPorIndice imprime?": "After append(slice[:2], slice[3:]...) on a slice of 5 items, which capacity (cap) does slice.RemoverItemPorIndice print?",
+	"Remover com append reaproveita o array original: o tamanho cai para 4, mas a capacidade continua 5.":                         "Removing with append reuses the original array: the length drops to 4, but the capacity stays 5.",
+	"Preveja a saída: qual o tamanho (len) de make([]int, 3, 10)?":                                                                "Predict the output: what is the length (len) of make([]int, 3, 10)?",
+	"O segundo argumento do make é o tamanho (len) e o terceiro é a capacidade (cap).":                                            "The second argument of make is the length (len) and the third is the capacity (cap).",
+	"variavel2 := variavel1 e depois variavel1++. Qual o valor final de variavel2?":                                               "variavel2 := variavel1 and then variavel1++. What is the final value of variavel2?",
+	"A atribuição copia o valor: alterar variavel1 depois não muda variavel2.":                                                    "Assignment copies the value: changing variavel1 afterwards does not change variavel2.",
+	"Preveja a saída: ponteiro = &variavel1 e depois variavel1++ (variavel1 começa em 10). Qual o valor de *ponteiro?":            "Predict the output: ponteiro = &variavel1 and then variavel1++ (variavel1 starts at 10). What is the value of *ponteiro?",
+	"O ponteiro guarda o endereço de variavel1, então *ponteiro sempre lê o valor atual dela.":                                    "The pointer holds the address of variavel1, so *ponteiro always reads its current value.",
+	"O que maps.DeletarItemDoMap imprime depois de delete(dados, \"nome\")?":                                                      "What does maps.DeletarItemDoMap print after delete(dados, \"nome\")?",
+	"delete remove a chave do map; ela não fica com valor vazio. O fmt imprime as chaves de um map em ordem alfabética.":          "delete removes the key from the map; it is not left with an empty value. fmt prints the keys of a map in alphabetical order.",
+	"Em if idade := numero; idade >= 18, com numero = 12, qual mensagem aparece?":                                                 "In if idade := numero; idade >= 18, with numero = 12, which message appears?",
+	"A variável declarada no if existe só dentro do if/else; como 12 < 18 o else é executado.":                                    "The variable declared in the if exists only inside the if/else; since 12 < 18 the else runs.",
+	"switchs.SwitchComRetorno usa numero = 12. O que ela devolve?":                                                                "switchs.SwitchComRetorno uses numero = 12. What does it return?",
+	"Nenhum case vai até 12, então o default é executado.":                                                                        "No case reaches 12, so the default runs.",
+	"Preveja a saída: qual o valor de funcoes.FuncaoRecursiva(15) (Fibonacci)?":                                                   "Predict the output: what is the value of funcoes.FuncaoRecursiva(15) (Fibonacci)?",
+	"A sequência começa 0, 1, 1, 2, 3, 5, 8... e a posição 15 é 610.":                                                             "The sequence starts 0, 1, 1, 2, 3, 5, 8... and position 15 is 610.",
+	"numero := 10 e funcoes.FuncaoPonteiro(w, &numero) faz *numero = *numero * -1. Qual o valor de numero depois da chamada?":     "numero := 10 and funcoes.FuncaoPonteiro(w, &numero) does *numero = *numero * -1. What is the value of numero after the call?",
+	"A função recebeu o endereço de numero, então a alteração vale fora dela também.":                                             "The function received the address of numero, so the change holds outside it too.",
+
+	// rastro
+	"PASSO %d: ":  "STEP %d: ",
+	"RESULTADO: ": "RESULT: ",
+	"O compilador para aqui e não verifica %s": "The program stops here and does not check %s",
+}
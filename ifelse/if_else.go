@@ -1,23 +1,27 @@
 package ifelse
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func IfElse() {
+func IfElse(w io.Writer) {
 	var idade int = 18
 
 	if idade >= 18 {
-		fmt.Println("Você é maior de idade")
+		fmt.Fprintln(w, idioma.T("Você é maior de idade"))
 	} else {
-		fmt.Println("Você é menor de idade")
+		fmt.Fprintln(w, idioma.T("Você é menor de idade"))
 	}
 }
 
-func IfElseInicializandoVariavel() {
+func IfElseInicializandoVariavel(w io.Writer) {
 	var numero int = 12
 
 	if idade := numero; idade >= 18 {
-		fmt.Println("Você é maior de idade")
+		fmt.Fprintln(w, idioma.T("Você é maior de idade"))
 	} else {
-		fmt.Println("Você é menor de idade")
+		fmt.Fprintln(w, idioma.T("Você é menor de idade"))
 	}
 }
@@ -0,0 +1,55 @@
+package interfaces
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"modulo/idioma"
+)
+
+// DescreverFormas escreve uma tabela com área, perímetro e caixa
+// delimitadora de cada forma, ou o erro de Validar. O laço só conhece a
+// interface Forma; cada tipo responde com o próprio método.
+func DescreverFormas(w io.Writer, formas ...Forma) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, idioma.T("FORMA\tÁREA\tPERÍMETRO\tCAIXA"))
+	for _, f := range formas {
+		nome := fmt.Sprintf("%T", f)
+		if err := f.Validar(); err != nil {
+			fmt.Fprintf(tw, idioma.T("%s\tinválida: %v\n"), nome, err)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%.2f\t%.2f\t%v\n", nome, f.Area(), f.Perimetro(), f.Limites())
+	}
+	tw.Flush()
+}
+
+// Formas mostra as formas do pacote atrás da mesma interface, a área de um
+// polígono em L pela fórmula do laço e formas recusadas por Validar.
+func Formas(w io.Writer) {
+	fmt.Fprintln(w)
+	DescreverFormas(w,
+		Retangulo{Altura: 10, Largura: 30},
+		Quadrado{Lado: 4, Origem: Ponto{1, 1}},
+		Circulo{Raio: 10},
+		Elipse{SemiEixoX: 5, SemiEixoY: 3, Centro: Ponto{2, 2}},
+		Triangulo{A: Ponto{0, 0}, B: Ponto{4, 0}, C: Ponto{0, 3}},
+		Poligono{Vertices: []Ponto{{0, 0}, {4, 0}, {4, 1}, {1, 1}, {1, 3}, {0, 3}}},
+	)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("Formas recusadas por Validar:"))
+	recusadas := []Forma{
+		Retangulo{Altura: -2, Largura: 3},
+		Circulo{Raio: -1},
+		Triangulo{A: Ponto{0, 0}, B: Ponto{1, 1}, C: Ponto{2, 2}},
+		Poligono{Vertices: []Ponto{{0, 0}, {1, 0}}},
+	}
+	for _, f := range recusadas {
+		err := f.Validar()
+		fmt.Fprintln(w, " ", err)
+		fmt.Fprintln(w, "    errors.Is(err, ErrDimensaoNegativa):", errors.Is(err, ErrDimensaoNegativa))
+	}
+}
@@ -0,0 +1,244 @@
+package interfaces
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"modulo/idioma"
+)
+
+// Ponto é uma posição no plano.
+type Ponto struct {
+	X, Y float64
+}
+
+// Caixa é um retângulo com lados paralelos aos eixos, de Min (canto inferior
+// esquerdo) a Max (canto superior direito).
+type Caixa struct {
+	Min, Max Ponto
+}
+
+func (c Caixa) Largura() float64 {
+	return c.Max.X - c.Min.X
+}
+
+func (c Caixa) Altura() float64 {
+	return c.Max.Y - c.Min.Y
+}
+
+func (c Caixa) String() string {
+	return fmt.Sprintf("(%g, %g)–(%g, %g)", c.Min.X, c.Min.Y, c.Max.X, c.Max.Y)
+}
+
+// Os defeitos que Validar encontra numa forma: medidas negativas ou que não
+// são números e polígonos que não cercam área.
+var (
+	// ErrDimensaoNegativa: um lado, raio ou semieixo menor que zero.
+	ErrDimensaoNegativa = errors.New("dimensão negativa")
+	// ErrNaoFinito: uma dimensão ou coordenada NaN ou infinita.
+	ErrNaoFinito = errors.New("não finito")
+	// ErrPoucosVertices: um polígono com menos de 3 vértices.
+	ErrPoucosVertices = errors.New("poucos vértices")
+	// ErrDegenerada: vértices alinhados, que não cercam área nenhuma.
+	ErrDegenerada = errors.New("forma degenerada")
+)
+
+// ErroForma aponta o campo que fez Validar recusar a forma e o valor que
+// estava nele. Num polígono o campo pode ser um vértice só, como
+// "Vertices[2]", ou a lista inteira, quando o problema é o conjunto.
+type ErroForma struct {
+	Forma string // o tipo, como "Retangulo"
+	Campo string // o campo com problema, como "Raio" ou "Vertices[2]"
+	Valor any
+	Causa error
+}
+
+func (e *ErroForma) Error() string {
+	var motivo string
+	switch e.Causa {
+	case ErrDimensaoNegativa:
+		motivo = idioma.T("não pode ser negativo")
+	case ErrNaoFinito:
+		motivo = idioma.T("precisa ser um número finito")
+	case ErrPoucosVertices:
+		motivo = idioma.T("um polígono precisa de pelo menos 3 vértices")
+	case ErrDegenerada:
+		motivo = idioma.T("os vértices estão alinhados e não formam uma figura")
+	default:
+		motivo = e.Causa.Error()
+	}
+	return fmt.Sprintf("%s.%s = %v: %s", e.Forma, e.Campo, e.Valor, motivo)
+}
+
+// Unwrap devolve o defeito, para errors.Is(err, ErrDegenerada) e os outros.
+func (e *ErroForma) Unwrap() error {
+	return e.Causa
+}
+
+// dimensao é um campo de uma forma que não pode ser negativo.
+type dimensao struct {
+	campo string
+	valor float64
+}
+
+// validarDimensoes devolve o erro da primeira dimensão negativa ou não
+// finita.
+func validarDimensoes(forma string, dimensoes ...dimensao) error {
+	for _, d := range dimensoes {
+		switch {
+		case math.IsNaN(d.valor) || math.IsInf(d.valor, 0):
+			return &ErroForma{Forma: forma, Campo: d.campo, Valor: d.valor, Causa: ErrNaoFinito}
+		case d.valor < 0:
+			return &ErroForma{Forma: forma, Campo: d.campo, Valor: d.valor, Causa: ErrDimensaoNegativa}
+		}
+	}
+	return nil
+}
+
+// Quadrado é um Retangulo com Altura e Largura iguais a Lado.
+type Quadrado struct {
+	Lado   float64
+	Origem Ponto
+}
+
+func (q Quadrado) retangulo() Retangulo {
+	return Retangulo{Altura: q.Lado, Largura: q.Lado, Origem: q.Origem}
+}
+
+func (q Quadrado) Area() float64 {
+	return q.retangulo().Area()
+}
+
+func (q Quadrado) Perimetro() float64 {
+	return q.retangulo().Perimetro()
+}
+
+func (q Quadrado) Limites() Caixa {
+	return q.retangulo().Limites()
+}
+
+func (q Quadrado) Validar() error {
+	return validarDimensoes("Quadrado", dimensao{"Lado", q.Lado})
+}
+
+// Elipse tem os eixos paralelos a X e Y, com os semieixos SemiEixoX e
+// SemiEixoY. Um Circulo é uma Elipse com os dois semieixos iguais.
+type Elipse struct {
+	SemiEixoX float64
+	SemiEixoY float64
+	Centro    Ponto
+}
+
+func (e Elipse) Area() float64 {
+	return math.Pi * e.SemiEixoX * e.SemiEixoY
+}
+
+// Perimetro usa a segunda aproximação de Ramanujan: o perímetro exato da
+// elipse não tem fórmula fechada, e o erro dela é desprezível para elipses
+// comuns e não passa de 0,04% nas mais achatadas. Com semieixos iguais dá
+// exatamente 2πr.
+func (e Elipse) Perimetro() float64 {
+	a, b := e.SemiEixoX, e.SemiEixoY
+	if a+b == 0 {
+		return 0
+	}
+	h := (a - b) * (a - b) / ((a + b) * (a + b))
+	return math.Pi * (a + b) * (1 + 3*h/(10+math.Sqrt(4-3*h)))
+}
+
+func (e Elipse) Limites() Caixa {
+	return Caixa{
+		Min: Ponto{e.Centro.X - e.SemiEixoX, e.Centro.Y - e.SemiEixoY},
+		Max: Ponto{e.Centro.X + e.SemiEixoX, e.Centro.Y + e.SemiEixoY},
+	}
+}
+
+func (e Elipse) Validar() error {
+	return validarDimensoes("Elipse", dimensao{"SemiEixoX", e.SemiEixoX}, dimensao{"SemiEixoY", e.SemiEixoY})
+}
+
+// Poligono é a figura fechada que liga os vértices na ordem, do último de
+// volta ao primeiro. Os lados não podem se cruzar: a área de um polígono
+// que cruza a si mesmo sai errada, e Validar não confere isso.
+type Poligono struct {
+	Vertices []Ponto
+}
+
+// Area usa a fórmula do laço (shoelace): a soma de x[i]*y[i+1] -
+// x[i+1]*y[i] em volta do polígono é o dobro da área, positiva no sentido
+// anti-horário e negativa no horário. Por isso a ordem dos vértices pode
+// ser qualquer uma das duas.
+func (p Poligono) Area() float64 {
+	soma := 0.0
+	for i, a := range p.Vertices {
+		b := p.Vertices[(i+1)%len(p.Vertices)]
+		soma += a.X*b.Y - b.X*a.Y
+	}
+	return math.Abs(soma) / 2
+}
+
+func (p Poligono) Perimetro() float64 {
+	total := 0.0
+	for i, a := range p.Vertices {
+		b := p.Vertices[(i+1)%len(p.Vertices)]
+		total += math.Hypot(b.X-a.X, b.Y-a.Y)
+	}
+	return total
+}
+
+func (p Poligono) Limites() Caixa {
+	if len(p.Vertices) == 0 {
+		return Caixa{}
+	}
+	c := Caixa{Min: p.Vertices[0], Max: p.Vertices[0]}
+	for _, v := range p.Vertices[1:] {
+		c.Min = Ponto{min(c.Min.X, v.X), min(c.Min.Y, v.Y)}
+		c.Max = Ponto{max(c.Max.X, v.X), max(c.Max.Y, v.Y)}
+	}
+	return c
+}
+
+func (p Poligono) Validar() error {
+	return p.validar("Poligono")
+}
+
+func (p Poligono) validar(forma string) error {
+	for i, v := range p.Vertices {
+		if math.IsNaN(v.X) || math.IsInf(v.X, 0) || math.IsNaN(v.Y) || math.IsInf(v.Y, 0) {
+			return &ErroForma{Forma: forma, Campo: fmt.Sprintf("Vertices[%d]", i), Valor: v, Causa: ErrNaoFinito}
+		}
+	}
+	if len(p.Vertices) < 3 {
+		return &ErroForma{Forma: forma, Campo: "Vertices", Valor: len(p.Vertices), Causa: ErrPoucosVertices}
+	}
+	if p.Area() == 0 {
+		return &ErroForma{Forma: forma, Campo: "Vertices", Valor: p.Vertices, Causa: ErrDegenerada}
+	}
+	return nil
+}
+
+// Triangulo é o Poligono de vértices A, B e C.
+type Triangulo struct {
+	A, B, C Ponto
+}
+
+func (t Triangulo) poligono() Poligono {
+	return Poligono{Vertices: []Ponto{t.A, t.B, t.C}}
+}
+
+func (t Triangulo) Area() float64 {
+	return t.poligono().Area()
+}
+
+func (t Triangulo) Perimetro() float64 {
+	return t.poligono().Perimetro()
+}
+
+func (t Triangulo) Limites() Caixa {
+	return t.poligono().Limites()
+}
+
+func (t Triangulo) Validar() error {
+	return t.poligono().validar("Triangulo")
+}
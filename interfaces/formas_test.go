@@ -0,0 +1,111 @@
+package interfaces
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func perto(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestFormas(t *testing.T) {
+	casos := []struct {
+		forma     Forma
+		area      float64
+		perimetro float64
+		caixa     Caixa
+	}{
+		{Retangulo{Altura: 10, Largura: 30}, 300, 80, Caixa{Ponto{0, 0}, Ponto{30, 10}}},
+		{Retangulo{Altura: 2, Largura: 3, Origem: Ponto{-1, 5}}, 6, 10, Caixa{Ponto{-1, 5}, Ponto{2, 7}}},
+		{Quadrado{Lado: 4, Origem: Ponto{1, 1}}, 16, 16, Caixa{Ponto{1, 1}, Ponto{5, 5}}},
+		{Circulo{Raio: 10}, 100 * math.Pi, 20 * math.Pi, Caixa{Ponto{-10, -10}, Ponto{10, 10}}},
+		{Elipse{SemiEixoX: 2, SemiEixoY: 2, Centro: Ponto{1, 0}}, 4 * math.Pi, 4 * math.Pi, Caixa{Ponto{-1, -2}, Ponto{3, 2}}},
+		{Triangulo{Ponto{0, 0}, Ponto{4, 0}, Ponto{0, 3}}, 6, 12, Caixa{Ponto{0, 0}, Ponto{4, 3}}},
+		// polígono em L, com os vértices no sentido horário
+		{Poligono{[]Ponto{{0, 3}, {1, 3}, {1, 1}, {4, 1}, {4, 0}, {0, 0}}}, 6, 14, Caixa{Ponto{0, 0}, Ponto{4, 3}}},
+	}
+	for _, c := range casos {
+		if err := c.forma.Validar(); err != nil {
+			t.Errorf("%#v.Validar() = %v", c.forma, err)
+		}
+		if a := c.forma.Area(); !perto(a, c.area) {
+			t.Errorf("%#v.Area() = %v, esperado %v", c.forma, a, c.area)
+		}
+		if p := c.forma.Perimetro(); !perto(p, c.perimetro) {
+			t.Errorf("%#v.Perimetro() = %v, esperado %v", c.forma, p, c.perimetro)
+		}
+		if l := c.forma.Limites(); l != c.caixa {
+			t.Errorf("%#v.Limites() = %v, esperado %v", c.forma, l, c.caixa)
+		}
+	}
+}
+
+func TestPerimetroDaElipse(t *testing.T) {
+	// semieixos 5 e 3: perímetro exato 25,526983...
+	if p := (Elipse{SemiEixoX: 5, SemiEixoY: 3}).Perimetro(); math.Abs(p-25.526983) > 1e-4 {
+		t.Errorf("Perimetro() = %v, esperado 25.526983", p)
+	}
+	// achatada ao máximo, a elipse vira um segmento percorrido ida e volta;
+	// é onde a aproximação mais erra, perto de 0,04%
+	if p := (Elipse{SemiEixoX: 1}).Perimetro(); math.Abs(p-4) > 4*0.0005 {
+		t.Errorf("Perimetro() do segmento = %v, esperado ~4", p)
+	}
+	if p := (Elipse{}).Perimetro(); p != 0 {
+		t.Errorf("Perimetro() sem semieixos = %v, esperado 0", p)
+	}
+}
+
+func TestValidar(t *testing.T) {
+	casos := []struct {
+		forma Forma
+		campo string
+		causa error
+	}{
+		{Retangulo{Altura: -2, Largura: 3}, "Altura", ErrDimensaoNegativa},
+		{Retangulo{Altura: 2, Largura: -3}, "Largura", ErrDimensaoNegativa},
+		{Quadrado{Lado: -1}, "Lado", ErrDimensaoNegativa},
+		{Circulo{Raio: math.Inf(1)}, "Raio", ErrNaoFinito},
+		{Elipse{SemiEixoX: 1, SemiEixoY: math.NaN()}, "SemiEixoY", ErrNaoFinito},
+		{Triangulo{Ponto{0, 0}, Ponto{1, 1}, Ponto{2, 2}}, "Vertices", ErrDegenerada},
+		{Triangulo{Ponto{0, 0}, Ponto{math.NaN(), 1}, Ponto{2, 0}}, "Vertices[1]", ErrNaoFinito},
+		{Poligono{[]Ponto{{0, 0}, {1, 0}}}, "Vertices", ErrPoucosVertices},
+		{Poligono{}, "Vertices", ErrPoucosVertices},
+	}
+	for _, c := range casos {
+		err := c.forma.Validar()
+		if !errors.Is(err, c.causa) {
+			t.Errorf("%#v.Validar() = %v, esperado %v", c.forma, err, c.causa)
+			continue
+		}
+		var e *ErroForma
+		if !errors.As(err, &e) || e.Campo != c.campo {
+			t.Errorf("%#v.Validar() = %#v, esperado ErroForma no campo %s", c.forma, err, c.campo)
+		}
+	}
+
+	// zero não é negativo: a forma existe, só não tem área
+	if err := (Retangulo{}).Validar(); err != nil {
+		t.Errorf("Retangulo{}.Validar() = %v, esperado nil", err)
+	}
+}
+
+func TestErroFormaError(t *testing.T) {
+	err := Circulo{Raio: -1}.Validar()
+	if esperado := "Circulo.Raio = -1: não pode ser negativo"; err == nil || err.Error() != esperado {
+		t.Errorf("Error() = %v, esperado %q", err, esperado)
+	}
+}
+
+func TestDescreverFormas(t *testing.T) {
+	var b strings.Builder
+	DescreverFormas(&b, Quadrado{Lado: 2}, Circulo{Raio: -1})
+	saida := b.String()
+	for _, trecho := range []string{"interfaces.Quadrado", "4.00", "8.00", "(0, 0)–(2, 2)", "Circulo.Raio = -1"} {
+		if !strings.Contains(saida, trecho) {
+			t.Errorf("saída sem %q:\n%s", trecho, saida)
+		}
+	}
+}
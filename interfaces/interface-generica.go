@@ -1,7 +1,10 @@
 package interfaces
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func Generica (interf interface{}){
-	fmt.Println(interf)
+func Generica (w io.Writer, interf interface{}){
+	fmt.Fprintln(w, interf)
 }
\ No newline at end of file
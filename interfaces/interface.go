@@ -1,33 +1,71 @@
+// Package interfaces mostra interfaces em Go com formas geométricas: cada
+// forma é um tipo diferente, mas todas satisfazem Forma, e quem recebe uma
+// Forma calcula área, perímetro e caixa delimitadora sem saber qual é.
 package interfaces
 
 import (
 	"fmt"
+	"io"
 	"math"
+	"modulo/idioma"
 )
 
-type forma interface {
-	area () float64
+// Forma é uma figura plana. Limites devolve a caixa delimitadora, o menor
+// retângulo com lados paralelos aos eixos que contém a figura. Validar
+// devolve um *ErroForma se alguma dimensão for negativa ou a figura não
+// puder existir.
+type Forma interface {
+	Area() float64
+	Perimetro() float64
+	Limites() Caixa
+	Validar() error
 }
 
-func EscreverArea (f forma) {
-	fmt.Printf("A area da forma é %0.2f", f.area())
+func EscreverArea(w io.Writer, f Forma) {
+	fmt.Fprintf(w, idioma.T("A area da forma é %0.2f"), f.Area())
 }
 
-type Retangulo struct{
-	Altura float64
+// Retangulo tem o canto inferior esquerdo em Origem.
+type Retangulo struct {
+	Altura  float64
 	Largura float64
+	Origem  Ponto
 }
 
-func (r Retangulo) area() float64 {
+func (r Retangulo) Area() float64 {
 	return r.Altura * r.Largura
 }
 
+func (r Retangulo) Perimetro() float64 {
+	return 2 * (r.Altura + r.Largura)
+}
+
+func (r Retangulo) Limites() Caixa {
+	return Caixa{Min: r.Origem, Max: Ponto{r.Origem.X + r.Largura, r.Origem.Y + r.Altura}}
+}
+
+func (r Retangulo) Validar() error {
+	return validarDimensoes("Retangulo", dimensao{"Altura", r.Altura}, dimensao{"Largura", r.Largura})
+}
+
+// Circulo tem o centro em Centro.
 type Circulo struct {
-	Raio float64
+	Raio   float64
+	Centro Ponto
 }
 
-func (c Circulo) area() float64{
+func (c Circulo) Area() float64 {
 	return math.Pi * (c.Raio * c.Raio)
 }
 
+func (c Circulo) Perimetro() float64 {
+	return 2 * math.Pi * c.Raio
+}
 
+func (c Circulo) Limites() Caixa {
+	return Elipse{SemiEixoX: c.Raio, SemiEixoY: c.Raio, Centro: c.Centro}.Limites()
+}
+
+func (c Circulo) Validar() error {
+	return validarDimensoes("Circulo", dimensao{"Raio", c.Raio})
+}
@@ -2,28 +2,32 @@ package loops
 
 import (
 	"fmt"
+	"io"
+	"modulo/idioma"
+	"modulo/relogio"
+	"sort"
 	"time"
 )
 
-func LoopFor() {
+func LoopFor(w io.Writer) {
 	for i := 0; i < 10; i++ {
-		fmt.Println("Loop For: ", i)
-		time.Sleep(time.Second * 1)
+		fmt.Fprintln(w, "Loop For: ", i)
+		relogio.Dormir(time.Second * 1)
 	}
 }
 
-func LoopWhile() {
+func LoopWhile(w io.Writer) {
 	i := 0
 	for i < 10 {
-		fmt.Println("Loop While: ", i)
+		fmt.Fprintln(w, "Loop While: ", i)
 		i++
 	}
 }
 
-func LoopDoWhile() {
+func LoopDoWhile(w io.Writer) {
 	i := 0
 	for {
-		fmt.Println("Loop Do While: ", i)
+		fmt.Fprintln(w, "Loop Do While: ", i)
 		i++
 		if i >= 10 {
 			break
@@ -31,34 +35,42 @@ func LoopDoWhile() {
 	}
 }
 
-func LoopForRange() {
+func LoopForRange(w io.Writer) {
 	slice := []string{"Golang", "Python", "Java", "JavaScript", "C#"}
 	for indice, valor := range slice {
-		fmt.Println("Loop For Range: O valor do indice é: ", indice)
-		fmt.Println("Loop For Range: O valor do valor é: ", valor)
+		fmt.Fprintln(w, idioma.T("Loop For Range: O valor do indice é: "), indice)
+		fmt.Fprintln(w, idioma.T("Loop For Range: O valor do valor é: "), valor)
 	}
 }
 
-func LoopForRangeString() {
+func LoopForRangeString(w io.Writer) {
 	texto := "Golang"
 	for indice, valor := range texto {
-		fmt.Println("Loop For Range String: O valor do indice é: ", indice)
-		fmt.Println("Loop For Range String: O valor do valor é: ", valor)
-		fmt.Println("Loop For Range String: O valor do valor é: ", string(valor))
+		fmt.Fprintln(w, idioma.T("Loop For Range String: O valor do indice é: "), indice)
+		fmt.Fprintln(w, idioma.T("Loop For Range String: O valor do valor é: "), valor)
+		fmt.Fprintln(w, idioma.T("Loop For Range String: O valor do valor é: "), string(valor))
 	}
 }
 
-func LoopForRangeMap() {
+// A ordem de iteração de um map em Go é aleatória a cada execução.
+// Para a saída ser sempre a mesma, percorremos as chaves ordenadas.
+func LoopForRangeMap(w io.Writer) {
 	mapa := map[string]string{"Golang": "2009", "Python": "1991", "Java": "1995"}
-	for chave, valor := range mapa {
-		fmt.Println("Loop For Range Map: O valor da chave é: ", chave)
-		fmt.Println("Loop For Range Map: O valor do valor é: ", valor)
+	chaves := make([]string, 0, len(mapa))
+	for chave := range mapa {
+		chaves = append(chaves, chave)
+	}
+	sort.Strings(chaves)
+	for _, chave := range chaves {
+		valor := mapa[chave]
+		fmt.Fprintln(w, idioma.T("Loop For Range Map: O valor da chave é: "), chave)
+		fmt.Fprintln(w, idioma.T("Loop For Range Map: O valor do valor é: "), valor)
 	}
 }
 
-// func LoopInfinito() {
+// func LoopInfinito(w io.Writer) {
 // 	for {
-// 		fmt.Println("Loop Infinito")
+// 		fmt.Fprintln(w, "Loop Infinito")
 // 		time.Sleep(time.Second * 1)
 // 	}
 // }
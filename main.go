@@ -1,36 +1,77 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	agrupamento_modulos "modulo/agrupamento_modulos"
+	"modulo/idioma"
+	"modulo/relogio"
+	"os"
 
 )
 
 func main() {
+	idiomaEscolhido := flag.String("idioma", "", "idioma das lições: pt-BR ou en (padrão: LANG)")
+	velocidade := flag.String("velocidade", "real", "velocidade das lições que esperam (ex.: loops): real, rapida ou instantanea")
+	flag.Parse()
+	if err := idioma.Configurar(*idiomaEscolhido); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	r, err := relogio.Velocidade(*velocidade)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	relogio.Definir(r)
+
+	if flag.Arg(0) == "servir" {
+		// No site a saída só aparece quando a lição termina, então sem
+		// --velocidade as lições não esperam.
+		if !flagInformada("velocidade") {
+			relogio.Definir(relogio.Instantaneo{})
+		}
+		servir(flag.Args()[1:])
+		return
+	}
+
+	// Todas as lições escrevem no mesmo destino (terminal)
+	saida := os.Stdout
+
 	// Módulos
-	agrupamento_modulos.ExecutarModulos()
+	agrupamento_modulos.ExecutarModulos(saida)
 
 	// Tipos Básicos
-	agrupamento_modulos.ExecutarTiposBasicos()
+	agrupamento_modulos.ExecutarTiposBasicos(saida)
 
 	// Operadores e Controle de Fluxo
-	agrupamento_modulos.ExecutarOperadores()
-	agrupamento_modulos.ExecutarControleFluxo()
+	agrupamento_modulos.ExecutarOperadores(saida)
+	agrupamento_modulos.ExecutarControleFluxo(saida)
 
 	// Estruturas de Dados
-	agrupamento_modulos.ExecutarEstruturasDados()
+	agrupamento_modulos.ExecutarEstruturasDados(saida)
 
 	// Funções Avançadas
-	agrupamento_modulos.ExecutarFuncoesAvancadas()
+	agrupamento_modulos.ExecutarFuncoesAvancadas(saida)
 
 	// Métodos e JSON
-	agrupamento_modulos.ExecutarMetodosEJSON()
+	agrupamento_modulos.ExecutarMetodosEJSON(saida)
 
 	//INTERFACES
-	agrupamento_modulos.ExecutarInterface()
-	agrupamento_modulos.ExecutarInerfaceGenerica()
+	agrupamento_modulos.ExecutarInterface(saida)
+	agrupamento_modulos.ExecutarInerfaceGenerica(saida)
 
 
-	fmt.Println("\n✅ Programa executado com sucesso!")
+	fmt.Fprintln(saida, idioma.T("\n✅ Programa executado com sucesso!"))
+
+}
 
+func flagInformada(nome string) bool {
+	informada := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == nome {
+			informada = true
+		}
+	})
+	return informada
 }
@@ -1,8 +1,11 @@
 package maps
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func AdicionarMapEmOutroMap(){
+func AdicionarMapEmOutroMap(w io.Writer){
 	dados := map[string]map[string]string{
 		"nome": {
 			"nome": "Mike",
@@ -17,7 +20,7 @@ func AdicionarMapEmOutroMap(){
 		"estado": "SP",
 		"cep": "1234567890",
 	}
-	fmt.Println("AdicionarMapEmOutroMap: ", dados)
-	fmt.Println("AdicionarMapEmOutroMap: ", dados["nome"]["nome"])
-	fmt.Println("AdicionarMapEmOutroMap: ", dados["endereco"]["rua"])
+	fmt.Fprintln(w, "AdicionarMapEmOutroMap: ", dados)
+	fmt.Fprintln(w, "AdicionarMapEmOutroMap: ", dados["nome"]["nome"])
+	fmt.Fprintln(w, "AdicionarMapEmOutroMap: ", dados["endereco"]["rua"])
 }
\ No newline at end of file
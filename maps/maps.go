@@ -1,36 +1,39 @@
 package maps
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func Maps() {
+func Maps(w io.Writer) {
 	dados := map[string]string{
 		"nome":  "Mike",
 		"idade": "30",
 		"email": "mike@example.com",
 	}
-	fmt.Println(dados)
-	fmt.Println(dados["nome"])
+	fmt.Fprintln(w, dados)
+	fmt.Fprintln(w, dados["nome"])
 }
 
 
-func DeletarItemDoMap() {
+func DeletarItemDoMap(w io.Writer) {
 	dados := map[string]string{
 		"nome": "Mike",
 		"idade": "30",
 		"email": "mike@example.com",
 	}
 	delete(dados, "nome")
-	fmt.Println(dados)
+	fmt.Fprintln(w, dados)
 }
 
-func AdicionarItemNoMap() {
+func AdicionarItemNoMap(w io.Writer) {
 	dados := map[string]string{
 		"nome": "Mike",
 		"idade": "30",
 		"email": "mike@example.com",
 	}
 	dados["telefone"] = "1234567890"
-	fmt.Println(dados)
+	fmt.Fprintln(w, dados)
 }
 
 
@@ -1,8 +1,11 @@
 package maps
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func MapAninhado() {
+func MapAninhado(w io.Writer) {
 	dados := map[string]map[string]string{
 		"nome": {
 			"nome": "Mike",
@@ -17,7 +20,7 @@ func MapAninhado() {
 			"cep": "1234567890",
 		},
 	}
-	fmt.Println(dados)
-	fmt.Println(dados["nome"]["nome"])
-	fmt.Println(dados["endereco"]["rua"])
+	fmt.Fprintln(w, dados)
+	fmt.Fprintln(w, dados["nome"]["nome"])
+	fmt.Fprintln(w, dados["endereco"]["rua"])
 }
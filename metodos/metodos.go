@@ -1,6 +1,10 @@
 package metodos
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
 type Usuario struct {
 	Nome string
@@ -13,8 +17,8 @@ func (u *Usuario) AtualizarIdade() {
 	u.Idade++
 }
 
-func (u Usuario) Salvar() {
-	fmt.Println("Salvando usuario: ", u.Nome)
-	fmt.Println("Email: ", u.Email)
-	fmt.Println("Senha: ", u.Senha)
+func (u Usuario) Salvar(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Salvando usuario: "), u.Nome)
+	fmt.Fprintln(w, "Email: ", u.Email)
+	fmt.Fprintln(w, idioma.T("Senha: "), u.Senha)
 }
\ No newline at end of file
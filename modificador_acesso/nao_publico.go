@@ -2,8 +2,10 @@ package modificador_acesso
 
 import (
 	"fmt"
+	"io"
+	"modulo/idioma"
 )
 
-func funcaoNaoPublica() {
-	fmt.Println("Funcao Nao Publica")
+func funcaoNaoPublica(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Funcao Nao Publica"))
 }
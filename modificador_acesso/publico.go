@@ -2,9 +2,11 @@ package modificador_acesso
 
 import (
 	"fmt"
+	"io"
+	"modulo/idioma"
 )
 
-func FuncaoPublica() {
-	fmt.Println("Funcao Publica")
-	funcaoNaoPublica()
+func FuncaoPublica(w io.Writer) {
+	fmt.Fprintln(w, idioma.T("Funcao Publica"))
+	funcaoNaoPublica(w)
 }
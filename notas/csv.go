@@ -0,0 +1,113 @@
+package notas
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"modulo/idioma"
+)
+
+// Turma é o conteúdo de um CSV de notas.
+type Turma struct {
+	Avaliacoes []string // os nomes das colunas de notas, na ordem do arquivo
+	Alunos     []Aluno
+}
+
+// Ler lê um CSV de notas. A primeira linha é o cabeçalho: a primeira coluna
+// tem o nome do aluno, uma coluna chamada "recuperacao" (opcional) tem a nota
+// da recuperação, que pode ficar vazia, e as demais são as avaliações:
+//
+//	nome,prova1,prova2,trabalho,recuperacao
+//	Ana,7,8,9,
+//	Bruno,4,5.5,3,7
+//
+// Planilhas em português costumam usar ";" entre as colunas e vírgula nos
+// decimais ("7,5"); quando o cabeçalho tem ";" esse formato também é aceito.
+// Linhas que começam com # são ignoradas.
+func Ler(r io.Reader) (*Turma, error) {
+	entrada := bufio.NewReader(r)
+	leitor := csv.NewReader(entrada)
+	if primeira, _ := entrada.Peek(entrada.Size()); strings.Contains(strings.SplitN(string(primeira), "\n", 2)[0], ";") {
+		leitor.Comma = ';'
+	}
+	leitor.Comment = '#'
+	leitor.TrimLeadingSpace = true
+
+	cabecalho, err := leitor.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New(idioma.T("o arquivo está vazio"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	recuperacao := -1
+	turma := &Turma{}
+	for i, coluna := range cabecalho[1:] {
+		if nome := strings.ToLower(strings.TrimSpace(coluna)); nome == "recuperacao" || nome == "recuperação" {
+			recuperacao = i + 1
+			continue
+		}
+		turma.Avaliacoes = append(turma.Avaliacoes, strings.TrimSpace(coluna))
+	}
+	if len(turma.Avaliacoes) == 0 {
+		return nil, errors.New(idioma.T("o cabeçalho precisa ter o nome e pelo menos uma coluna de notas"))
+	}
+
+	for {
+		registro, err := leitor.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		linha, _ := leitor.FieldPos(0)
+		aluno := Aluno{Nome: strings.TrimSpace(registro[0])}
+		for i, valor := range registro[1:] {
+			coluna := cabecalho[i+1]
+			valor = strings.TrimSpace(valor)
+			if i+1 == recuperacao && valor == "" {
+				continue
+			}
+			nota, err := lerNota(valor, leitor.Comma == ';')
+			if err != nil {
+				return nil, fmt.Errorf(idioma.T("linha %d, coluna %q: %v"), linha, coluna, err)
+			}
+			if i+1 == recuperacao {
+				aluno.Recuperacao = &nota
+			} else {
+				aluno.Notas = append(aluno.Notas, nota)
+			}
+		}
+		turma.Alunos = append(turma.Alunos, aluno)
+	}
+	return turma, nil
+}
+
+// lerNota converte o texto de uma célula numa nota de 0 a 10.
+func lerNota(valor string, virgulaDecimal bool) (float64, error) {
+	if virgulaDecimal {
+		valor = strings.Replace(valor, ",", ".", 1)
+	}
+	if valor == "" {
+		return 0, errors.New(idioma.T("nota vazia"))
+	}
+	nota, err := strconv.ParseFloat(valor, 64)
+	if err != nil {
+		return 0, fmt.Errorf(idioma.T("%q não é um número"), valor)
+	}
+	// NaN falha nas duas comparações abaixo e passaria como nota válida
+	if math.IsNaN(nota) || math.IsInf(nota, 0) {
+		return 0, fmt.Errorf(idioma.T("nota %v não é um número finito"), nota)
+	}
+	if nota < 0 || nota > 10 {
+		return 0, fmt.Errorf(idioma.T("nota %v fora de 0 a 10"), nota)
+	}
+	return nota, nil
+}
@@ -0,0 +1,226 @@
+// Package notas calcula a situação de cada aluno de uma turma, continuando o
+// que funcoes.AlunoAprovado faz com duas notas e média fixa 6: aqui as notas
+// têm pesos, as médias de aprovação são configuráveis, quem fica perto da
+// média faz recuperação e a média pode ser arredondada antes de comparar.
+package notas
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"modulo/genericos"
+	"modulo/idioma"
+)
+
+// Arredondamento é a regra aplicada à média antes de compará-la com as
+// médias de Regras.
+type Arredondamento string
+
+const (
+	SemArredondar  Arredondamento = "nenhum"
+	Decimo         Arredondamento = "decimo"     // para o décimo mais próximo: 5.96 vira 6
+	MeioPonto      Arredondamento = "meio"       // para o 0.5 mais próximo: 5.74 vira 5.5
+	MeioPontoAcima Arredondamento = "meio-acima" // para o próximo 0.5 acima: 5.51 vira 6
+	Inteiro        Arredondamento = "inteiro"    // para o inteiro mais próximo: 5.5 vira 6
+)
+
+// Arredondamentos lista as regras aceitas, na ordem em que aparecem na ajuda.
+func Arredondamentos() []string {
+	return []string{string(SemArredondar), string(Decimo), string(MeioPonto), string(MeioPontoAcima), string(Inteiro)}
+}
+
+// Rotulo descreve a regra para quem lê o boletim; o valor da constante é o
+// que se digita em --arredondamento.
+func (a Arredondamento) Rotulo() string {
+	switch a {
+	case Decimo:
+		return idioma.T("décimo mais próximo")
+	case MeioPonto:
+		return idioma.T("meio ponto mais próximo")
+	case MeioPontoAcima:
+		return idioma.T("meio ponto acima")
+	case Inteiro:
+		return idioma.T("inteiro mais próximo")
+	}
+	return idioma.T("sem arredondamento")
+}
+
+// Aplicar arredonda a média segundo a regra.
+func (a Arredondamento) Aplicar(media float64) float64 {
+	// a margem evita que uma média como 5.55, guardada como 5.5499999...,
+	// arredonde para baixo
+	const margem = 1e-9
+	switch a {
+	case Decimo:
+		return math.Round(media*10+margem) / 10
+	case MeioPonto:
+		return math.Round(media*2+margem) / 2
+	case MeioPontoAcima:
+		return math.Ceil(media*2-margem) / 2
+	case Inteiro:
+		return math.Round(media + margem)
+	}
+	return media
+}
+
+// Regras define como a média é calculada e o que ela significa.
+//
+// Com média abaixo de MediaRecuperacao o aluno é reprovado direto; a partir
+// de MediaAprovacao, aprovado. Entre as duas ele faz a prova de recuperação e a
+// média final é a média entre a média das avaliações e a nota da recuperação,
+// que precisa chegar a MediaFinal.
+type Regras struct {
+	Pesos            []float64 // um por avaliação; vazio dá peso 1 a todas
+	MediaAprovacao   float64
+	MediaRecuperacao float64
+	MediaFinal       float64
+	Arredondamento   Arredondamento
+}
+
+// RegrasPadrao aprova com 6, dá recuperação a partir de 4 e exige 5 depois
+// dela, arredondando para o décimo mais próximo.
+func RegrasPadrao() Regras {
+	return Regras{MediaAprovacao: 6, MediaRecuperacao: 4, MediaFinal: 5, Arredondamento: Decimo}
+}
+
+// Validar confere se as regras fazem sentido para uma turma com o número de
+// avaliações informado.
+func (r Regras) Validar(avaliacoes int) error {
+	medias := []struct {
+		nome  string
+		valor float64
+	}{
+		{idioma.T("a média de aprovação"), r.MediaAprovacao},
+		{idioma.T("a média de recuperação"), r.MediaRecuperacao},
+		{idioma.T("a média final"), r.MediaFinal},
+	}
+	for _, m := range medias {
+		// escrito assim, NaN também fica de fora
+		if !(m.valor >= 0 && m.valor <= 10) {
+			return fmt.Errorf(idioma.T("%s (%v) precisa ser um número de 0 a 10"), m.nome, m.valor)
+		}
+	}
+	switch {
+	case len(r.Pesos) > 0 && len(r.Pesos) != avaliacoes:
+		return fmt.Errorf(idioma.T("%d pesos para %d avaliações"), len(r.Pesos), avaliacoes)
+	case genericos.Somar(r.Pesos...) <= 0 && len(r.Pesos) > 0:
+		return errors.New(idioma.T("a soma dos pesos precisa ser maior que zero"))
+	case r.MediaRecuperacao > r.MediaAprovacao:
+		return fmt.Errorf(idioma.T("a média de recuperação (%v) é maior que a de aprovação (%v)"), r.MediaRecuperacao, r.MediaAprovacao)
+	}
+	for _, peso := range r.Pesos {
+		if math.IsNaN(peso) || math.IsInf(peso, 0) {
+			return fmt.Errorf(idioma.T("peso %v não é um número finito"), peso)
+		}
+		if peso < 0 {
+			return fmt.Errorf(idioma.T("peso negativo: %v"), peso)
+		}
+	}
+	for _, a := range Arredondamentos() {
+		if string(r.Arredondamento) == a {
+			return nil
+		}
+	}
+	return fmt.Errorf(idioma.T("arredondamento %q inválido (use %s)"), r.Arredondamento, strings.Join(Arredondamentos(), ", "))
+}
+
+// peso devolve o peso da avaliação i.
+func (r Regras) peso(i int) float64 {
+	if len(r.Pesos) == 0 {
+		return 1
+	}
+	return r.Pesos[i]
+}
+
+// Situacao é o resultado de um aluno.
+type Situacao int
+
+const (
+	Aprovado Situacao = iota
+	AprovadoNaRecuperacao
+	EmRecuperacao // precisa da recuperação e ainda não tem nota dela
+	Reprovado
+)
+
+func (s Situacao) String() string {
+	switch s {
+	case Aprovado:
+		return idioma.T("aprovado")
+	case AprovadoNaRecuperacao:
+		return idioma.T("aprovado na recuperação")
+	case EmRecuperacao:
+		return idioma.T("em recuperação")
+	}
+	return idioma.T("reprovado")
+}
+
+// Aluno é uma linha do CSV: as notas das avaliações, na ordem das colunas,
+// e a nota da recuperação, se ele já fez a prova.
+type Aluno struct {
+	Nome        string
+	Notas       []float64
+	Recuperacao *float64
+}
+
+// Boletim é a situação de um aluno calculada com um conjunto de Regras.
+type Boletim struct {
+	Aluno
+	Pesos      []float64
+	Media      float64 // média ponderada, já arredondada
+	MediaFinal float64 // igual a Media quando não houve recuperação
+	Situacao   Situacao
+}
+
+// Avaliar calcula o boletim do aluno. As regras precisam ter sido validadas
+// para o número de notas do aluno.
+func Avaliar(aluno Aluno, regras Regras) Boletim {
+	b := Boletim{Aluno: aluno, Pesos: make([]float64, len(aluno.Notas))}
+	for i := range aluno.Notas {
+		b.Pesos[i] = regras.peso(i)
+	}
+	exata, _ := b.mediaExata()
+	b.Media = regras.Arredondamento.Aplicar(exata)
+	b.MediaFinal = b.Media
+
+	switch {
+	case b.Media >= regras.MediaAprovacao:
+		b.Situacao = Aprovado
+	case b.Media < regras.MediaRecuperacao:
+		b.Situacao = Reprovado
+	case aluno.Recuperacao == nil:
+		b.Situacao = EmRecuperacao
+	default:
+		b.MediaFinal = regras.Arredondamento.Aplicar((b.Media + *aluno.Recuperacao) / 2)
+		b.Situacao = Reprovado
+		if b.MediaFinal >= regras.MediaFinal {
+			b.Situacao = AprovadoNaRecuperacao
+		}
+	}
+	return b
+}
+
+// NotaNecessaria devolve a menor nota de recuperação, em décimos, que leva
+// a média final a regras.MediaFinal, já contando o arredondamento. ok é
+// false quando nem 10 basta.
+func (b Boletim) NotaNecessaria(regras Regras) (nota float64, ok bool) {
+	for decimos := 0; decimos <= 100; decimos++ {
+		nota = float64(decimos) / 10
+		if regras.Arredondamento.Aplicar((b.Media+nota)/2) >= regras.MediaFinal {
+			return nota, true
+		}
+	}
+	return 0, false
+}
+
+// Aprovado diz se o aluno passou, com ou sem recuperação.
+func (b Boletim) Aprovado() bool {
+	return b.Situacao == Aprovado || b.Situacao == AprovadoNaRecuperacao
+}
+
+// formatar mostra uma nota com no máximo duas casas e sem zeros à direita.
+func formatar(n float64) string {
+	return strconv.FormatFloat(math.Round(n*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,255 @@
+package notas
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"modulo/idioma"
+)
+
+func TestArredondamento(t *testing.T) {
+	casos := []struct {
+		regra           Arredondamento
+		media, esperado float64
+	}{
+		{SemArredondar, 5.96, 5.96},
+		{Decimo, 5.96, 6},
+		{Decimo, 5.55, 5.6}, // 5.55 é guardado como 5.5499999...
+		{Decimo, 4.05, 4.1},
+		{MeioPonto, 5.74, 5.5},
+		{MeioPonto, 5.75, 6},
+		{MeioPontoAcima, 5.51, 6},
+		{MeioPontoAcima, 5.5, 5.5},
+		{MeioPontoAcima, 5.0000000001, 5},
+		{Inteiro, 5.5, 6},
+		{Inteiro, 5.49, 5},
+	}
+	for _, c := range casos {
+		if obtido := c.regra.Aplicar(c.media); obtido != c.esperado {
+			t.Errorf("%s.Aplicar(%v) = %v, esperado %v", c.regra, c.media, obtido, c.esperado)
+		}
+	}
+}
+
+func nota(n float64) *float64 { return &n }
+
+func TestAvaliar(t *testing.T) {
+	regras := RegrasPadrao()
+	regras.Pesos = []float64{3, 3, 4}
+	casos := []struct {
+		aluno        Aluno
+		media, final float64
+		situacao     Situacao
+	}{
+		{Aluno{"Ana", []float64{7, 8, 9}, nil}, 8.1, 8.1, Aprovado},
+		{Aluno{"Beto", []float64{6, 6, 5.9}, nil}, 6, 6, Aprovado}, // 5.96 arredonda para 6
+		{Aluno{"Bruno", []float64{4, 5.5, 3}, nota(7)}, 4.1, 5.6, AprovadoNaRecuperacao},
+		{Aluno{"Elisa", []float64{5, 6, 5.9}, nil}, 5.7, 5.7, EmRecuperacao},
+		{Aluno{"Felipe", []float64{5, 5, 4.5}, nota(3)}, 4.8, 3.9, Reprovado},
+		{Aluno{"Davi", []float64{2, 3.5, 4}, nota(10)}, 3.3, 3.3, Reprovado}, // abaixo de 4 nem faz recuperação
+		{Aluno{"Gabi", []float64{9, 9, 9}, nota(2)}, 9, 9, Aprovado},         // recuperação ignorada
+	}
+	for _, c := range casos {
+		b := Avaliar(c.aluno, regras)
+		if b.Media != c.media || b.MediaFinal != c.final || b.Situacao != c.situacao {
+			t.Errorf("Avaliar(%s) = média %v, final %v, %v; esperado %v, %v, %v",
+				c.aluno.Nome, b.Media, b.MediaFinal, b.Situacao, c.media, c.final, c.situacao)
+		}
+	}
+}
+
+func TestAvaliarSemPesos(t *testing.T) {
+	regras := RegrasPadrao()
+	regras.Arredondamento = SemArredondar
+	b := Avaliar(Aluno{Nome: "Ana", Notas: []float64{7, 8}}, regras)
+	if b.Media != 7.5 || !b.Aprovado() {
+		t.Errorf("Avaliar sem pesos = %v %v, esperado 7.5 aprovado (como AlunoAprovado)", b.Media, b.Situacao)
+	}
+}
+
+func TestNotaNecessaria(t *testing.T) {
+	regras := RegrasPadrao()
+	// (5.7 + 4.2) / 2 = 4.95, que arredonda para 5
+	if n, ok := (Boletim{Media: 5.7}).NotaNecessaria(regras); !ok || n != 4.2 {
+		t.Errorf("NotaNecessaria(5.7) = %v, %v; esperado 4.2, true", n, ok)
+	}
+	regras.Arredondamento = SemArredondar
+	if n, ok := (Boletim{Media: 5.7}).NotaNecessaria(regras); !ok || n != 4.3 {
+		t.Errorf("NotaNecessaria(5.7) sem arredondar = %v, %v; esperado 4.3, true", n, ok)
+	}
+	regras.MediaFinal = 8
+	if _, ok := (Boletim{Media: 4}).NotaNecessaria(regras); ok {
+		t.Errorf("NotaNecessaria(4) com média final 8 = ok, esperado nem 10 basta")
+	}
+}
+
+func TestValidar(t *testing.T) {
+	casos := []struct {
+		mudar func(*Regras)
+		erro  string
+	}{
+		{func(r *Regras) {}, ""},
+		{func(r *Regras) { r.Pesos = []float64{1, 2, 3} }, ""},
+		{func(r *Regras) { r.Pesos = []float64{1, 2} }, "2 pesos para 3 avaliações"},
+		{func(r *Regras) { r.Pesos = []float64{0, 0, 0} }, "soma dos pesos"},
+		{func(r *Regras) { r.Pesos = []float64{-1, 2, 3} }, "peso negativo"},
+		{func(r *Regras) { r.Pesos = []float64{math.NaN(), 2, 3} }, "peso NaN não é um número finito"},
+		{func(r *Regras) { r.Pesos = []float64{math.Inf(1), 2, 3} }, "peso +Inf não é um número finito"},
+		{func(r *Regras) { r.MediaRecuperacao = 7 }, "maior que a de aprovação"},
+		{func(r *Regras) { r.MediaAprovacao = math.NaN() }, "a média de aprovação (NaN) precisa ser um número de 0 a 10"},
+		{func(r *Regras) { r.MediaAprovacao, r.MediaRecuperacao = math.NaN(), math.NaN() }, "a média de aprovação (NaN)"},
+		{func(r *Regras) { r.MediaRecuperacao = math.Inf(-1) }, "a média de recuperação (-Inf)"},
+		{func(r *Regras) { r.MediaFinal = math.Inf(1) }, "a média final (+Inf)"},
+		{func(r *Regras) { r.MediaAprovacao = 11 }, "a média de aprovação (11) precisa ser um número de 0 a 10"},
+		{func(r *Regras) { r.MediaRecuperacao = -1 }, "a média de recuperação (-1)"},
+		{func(r *Regras) { r.MediaFinal = 10.5 }, "a média final (10.5)"},
+		{func(r *Regras) { r.MediaAprovacao, r.MediaRecuperacao, r.MediaFinal = 10, 0, 0 }, ""},
+		{func(r *Regras) { r.Arredondamento = "pra cima" }, `arredondamento "pra cima" inválido`},
+	}
+	for _, c := range casos {
+		regras := RegrasPadrao()
+		c.mudar(&regras)
+		err := regras.Validar(3)
+		switch {
+		case c.erro == "" && err != nil:
+			t.Errorf("Validar(%+v) = %v, esperado nil", regras, err)
+		case c.erro != "" && (err == nil || !strings.Contains(err.Error(), c.erro)):
+			t.Errorf("Validar(%+v) = %v, esperado erro com %q", regras, err, c.erro)
+		}
+	}
+}
+
+const turmaCSV = `nome,prova1,prova2,trabalho,recuperacao
+# Elisa ainda não fez a recuperação
+Ana,7,8,9,
+Bruno,4,5.5,3,7
+Elisa,5,6,5.9,
+`
+
+func TestLer(t *testing.T) {
+	turma, err := Ler(strings.NewReader(turmaCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obtido := strings.Join(turma.Avaliacoes, ","); obtido != "prova1,prova2,trabalho" {
+		t.Errorf("Avaliacoes = %s", obtido)
+	}
+	if len(turma.Alunos) != 3 {
+		t.Fatalf("%d alunos, esperado 3", len(turma.Alunos))
+	}
+	bruno := turma.Alunos[1]
+	if bruno.Nome != "Bruno" || len(bruno.Notas) != 3 || bruno.Notas[1] != 5.5 || bruno.Recuperacao == nil || *bruno.Recuperacao != 7 {
+		t.Errorf("Bruno = %+v", bruno)
+	}
+	if turma.Alunos[0].Recuperacao != nil {
+		t.Errorf("Ana tem recuperação %v, esperado nil", *turma.Alunos[0].Recuperacao)
+	}
+}
+
+func TestLerPontoEVirgula(t *testing.T) {
+	turma, err := Ler(strings.NewReader("Nome;P1;P2;Recuperação\nAna;7,5;8;\nBruno;4;5;6,5\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ana := turma.Alunos[0]; ana.Notas[0] != 7.5 {
+		t.Errorf("Ana = %+v, esperado 7,5 lido como 7.5", ana)
+	}
+	if bruno := turma.Alunos[1]; bruno.Recuperacao == nil || *bruno.Recuperacao != 6.5 {
+		t.Errorf("Bruno = %+v, esperado recuperação 6.5", bruno)
+	}
+}
+
+func TestLerErros(t *testing.T) {
+	casos := []struct{ csv, erro string }{
+		{"", "vazio"},
+		{"nome,recuperacao\nAna,5\n", "pelo menos uma coluna de notas"},
+		{"nome,p1\nAna,7\nBruno,onze\n", `linha 3, coluna "p1": "onze" não é um número`},
+		{"nome,p1\nAna,11\n", "fora de 0 a 10"},
+		{"nome,p1,p2\nAna,NaN,7\n", `linha 2, coluna "p1": nota NaN não é um número finito`},
+		{"nome,p1\nAna,-Inf\n", "nota -Inf não é um número finito"},
+		{"nome,p1,p2\nAna,7,\n", "nota vazia"},
+		{"nome,p1,p2\nAna,7\n", "wrong number of fields"},
+	}
+	for _, c := range casos {
+		_, err := Ler(strings.NewReader(c.csv))
+		if err == nil || !strings.Contains(err.Error(), c.erro) {
+			t.Errorf("Ler(%q) = %v, esperado erro com %q", c.csv, err, c.erro)
+		}
+	}
+}
+
+func TestRelatorio(t *testing.T) {
+	turma, err := Ler(strings.NewReader(turmaCSV))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AvaliarTurma(turma, Regras{Pesos: []float64{1}}); err == nil {
+		t.Error("AvaliarTurma com um peso para três avaliações não deu erro")
+	}
+	regras := RegrasPadrao()
+	regras.Pesos = []float64{3, 3, 4}
+	relatorio, err := AvaliarTurma(turma, regras)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := relatorio.Estatisticas()
+	if e.Alunos != 3 || e.PorSituacao[Aprovado] != 1 || e.PorSituacao[AprovadoNaRecuperacao] != 1 || e.PorSituacao[EmRecuperacao] != 1 {
+		t.Errorf("PorSituacao = %v", e.PorSituacao)
+	}
+	if e.Maior.Nome != "Ana" || e.Menor.Nome != "Bruno" {
+		t.Errorf("maior %s, menor %s; esperado Ana e Bruno", e.Maior.Nome, e.Menor.Nome)
+	}
+	if media := (8.1 + 5.6 + 5.7) / 3; math.Abs(e.Media-media) > 1e-9 {
+		t.Errorf("Media = %v, esperado %v", e.Media, media)
+	}
+	if len(e.MediaPorAvaliacao) != 3 || math.Abs(e.MediaPorAvaliacao[0]-16.0/3) > 1e-9 {
+		t.Errorf("MediaPorAvaliacao = %v", e.MediaPorAvaliacao)
+	}
+
+	var saida bytes.Buffer
+	if err := relatorio.Escrever(&saida); err != nil {
+		t.Fatal(err)
+	}
+	for _, trecho := range []string{
+		"média: (4×3 + 5.5×3 + 3×4) / 10 = 4.05, arredondada para 4.1 (décimo mais próximo)",
+		"recuperação: 7 → média final (4.1 + 7) / 2 = 5.6",
+		"recuperação: precisa de pelo menos 4.2 para chegar a 5",
+		"TURMA: 3 alunos",
+		"aprovados: 2 (1 na recuperação) · em recuperação: 1 · reprovados: 0",
+	} {
+		if !strings.Contains(saida.String(), trecho) {
+			t.Errorf("relatório sem %q:\n%s", trecho, saida.String())
+		}
+	}
+}
+
+func TestEstatisticasTurmaVazia(t *testing.T) {
+	relatorio, err := AvaliarTurma(&Turma{Avaliacoes: []string{"p1"}}, RegrasPadrao())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := relatorio.Estatisticas(); e.Alunos != 0 || e.Media != 0 {
+		t.Errorf("Estatisticas da turma vazia = %+v", e)
+	}
+}
+
+func TestRotulo(t *testing.T) {
+	rotulos := map[string]bool{}
+	for _, a := range Arredondamentos() {
+		rotulos[Arredondamento(a).Rotulo()] = true
+	}
+	if len(rotulos) != len(Arredondamentos()) {
+		t.Errorf("rótulos = %v, esperado um diferente para cada regra", rotulos)
+	}
+
+	idioma.Definir(idioma.Ingles)
+	defer idioma.Definir(idioma.Portugues)
+	for _, a := range Arredondamentos() {
+		if rotulo := Arredondamento(a).Rotulo(); rotulos[rotulo] {
+			t.Errorf("Rotulo de %s em inglês = %q, sem tradução", a, rotulo)
+		}
+	}
+}
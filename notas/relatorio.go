@@ -0,0 +1,148 @@
+package notas
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"modulo/genericos"
+	"modulo/idioma"
+)
+
+// Relatorio é o boletim de cada aluno de uma turma, calculado com as mesmas
+// regras.
+type Relatorio struct {
+	Avaliacoes []string
+	Regras     Regras
+	Boletins   []Boletim
+}
+
+// AvaliarTurma valida as regras para as avaliações da turma e calcula o
+// boletim de cada aluno.
+func AvaliarTurma(turma *Turma, regras Regras) (*Relatorio, error) {
+	if err := regras.Validar(len(turma.Avaliacoes)); err != nil {
+		return nil, err
+	}
+	boletins := genericos.Mapear(turma.Alunos, func(a Aluno) Boletim { return Avaliar(a, regras) })
+	return &Relatorio{Avaliacoes: turma.Avaliacoes, Regras: regras, Boletins: boletins}, nil
+}
+
+// Estatisticas resume a turma. As médias usam a média final de cada aluno.
+type Estatisticas struct {
+	Alunos            int
+	PorSituacao       map[Situacao]int
+	Media             float64
+	Maior, Menor      Boletim
+	MediaPorAvaliacao []float64 // na ordem de Relatorio.Avaliacoes
+}
+
+// Estatisticas calcula o resumo da turma. Com a turma vazia, só Alunos e
+// PorSituacao são preenchidos.
+func (r *Relatorio) Estatisticas() Estatisticas {
+	e := Estatisticas{Alunos: len(r.Boletins), PorSituacao: map[Situacao]int{}}
+	for situacao, boletins := range genericos.Agrupar(r.Boletins, func(b Boletim) Situacao { return b.Situacao }) {
+		e.PorSituacao[situacao] = len(boletins)
+	}
+	if e.Alunos == 0 {
+		return e
+	}
+
+	finais := genericos.Mapear(r.Boletins, func(b Boletim) float64 { return b.MediaFinal })
+	e.Media = genericos.Somar(finais...) / float64(e.Alunos)
+	e.Maior = genericos.Reduzir(r.Boletins[1:], r.Boletins[0], func(maior, b Boletim) Boletim {
+		if b.MediaFinal > maior.MediaFinal {
+			return b
+		}
+		return maior
+	})
+	e.Menor = genericos.Reduzir(r.Boletins[1:], r.Boletins[0], func(menor, b Boletim) Boletim {
+		if b.MediaFinal < menor.MediaFinal {
+			return b
+		}
+		return menor
+	})
+	for i := range r.Avaliacoes {
+		notas := genericos.Mapear(r.Boletins, func(b Boletim) float64 { return b.Notas[i] })
+		e.MediaPorAvaliacao = append(e.MediaPorAvaliacao, genericos.Somar(notas...)/float64(e.Alunos))
+	}
+	return e
+}
+
+// Escrever mostra em w o boletim de cada aluno e, no fim, as estatísticas
+// da turma.
+func (r *Relatorio) Escrever(w io.Writer) error {
+	for _, b := range r.Boletins {
+		b.Escrever(w, r.Avaliacoes, r.Regras)
+		fmt.Fprintln(w)
+	}
+
+	e := r.Estatisticas()
+	fmt.Fprintf(w, idioma.T("TURMA: %d alunos\n"), e.Alunos)
+	if e.Alunos == 0 {
+		return nil
+	}
+	fmt.Fprintf(w, idioma.T("  aprovados: %d (%d na recuperação) · em recuperação: %d · reprovados: %d\n"),
+		e.PorSituacao[Aprovado]+e.PorSituacao[AprovadoNaRecuperacao], e.PorSituacao[AprovadoNaRecuperacao],
+		e.PorSituacao[EmRecuperacao], e.PorSituacao[Reprovado])
+	fmt.Fprintf(w, idioma.T("  média da turma: %s · maior média: %s (%s) · menor média: %s (%s)\n"),
+		formatar(e.Media), formatar(e.Maior.MediaFinal), e.Maior.Nome, formatar(e.Menor.MediaFinal), e.Menor.Nome)
+	medias := make([]string, len(r.Avaliacoes))
+	for i, avaliacao := range r.Avaliacoes {
+		medias[i] = avaliacao + " " + formatar(e.MediaPorAvaliacao[i])
+	}
+	_, err := fmt.Fprintf(w, idioma.T("  média por avaliação: %s\n"), strings.Join(medias, " · "))
+	return err
+}
+
+// Escrever mostra o boletim com a conta da média, para o aluno conferir.
+func (b Boletim) Escrever(w io.Writer, avaliacoes []string, regras Regras) {
+	fmt.Fprintln(w, b.Nome)
+
+	notas := make([]string, len(b.Notas))
+	termos := make([]string, len(b.Notas))
+	pesosIguais := true
+	for i, nota := range b.Notas {
+		notas[i] = fmt.Sprintf(idioma.T("%s %s (peso %s)"), avaliacoes[i], formatar(nota), formatar(b.Pesos[i]))
+		termos[i] = formatar(nota) + "×" + formatar(b.Pesos[i])
+		pesosIguais = pesosIguais && b.Pesos[i] == b.Pesos[0]
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(notas, " · "))
+
+	exata, soma := b.mediaExata()
+	conta := fmt.Sprintf("(%s) / %s", strings.Join(termos, " + "), formatar(soma))
+	if pesosIguais {
+		conta = fmt.Sprintf("(%s) / %d", strings.Join(genericos.Mapear(b.Notas, formatar), " + "), len(b.Notas))
+	}
+	fmt.Fprintf(w, idioma.T("  média: %s = %s"), conta, formatar(exata))
+	if formatar(exata) != formatar(b.Media) {
+		fmt.Fprintf(w, idioma.T(", arredondada para %s (%s)"), formatar(b.Media), regras.Arredondamento.Rotulo())
+	}
+	fmt.Fprintln(w)
+
+	switch {
+	case b.Recuperacao != nil && b.Situacao != Aprovado && b.Media >= regras.MediaRecuperacao:
+		fmt.Fprintf(w, idioma.T("  recuperação: %s → média final (%s + %s) / 2 = %s\n"),
+			formatar(*b.Recuperacao), formatar(b.Media), formatar(*b.Recuperacao), formatar(b.MediaFinal))
+	case b.Situacao == EmRecuperacao:
+		if necessaria, ok := b.NotaNecessaria(regras); ok {
+			fmt.Fprintf(w, idioma.T("  recuperação: precisa de pelo menos %s para chegar a %s\n"), formatar(necessaria), formatar(regras.MediaFinal))
+		} else {
+			fmt.Fprintf(w, idioma.T("  recuperação: nem 10 leva a média final a %s\n"), formatar(regras.MediaFinal))
+		}
+	}
+	fmt.Fprintf(w, idioma.T("  situação: %s\n"), b.Situacao)
+}
+
+// mediaExata é a média ponderada antes do arredondamento, junto da soma dos
+// pesos.
+func (b Boletim) mediaExata() (media, pesos float64) {
+	soma := 0.0
+	for i, nota := range b.Notas {
+		soma += nota * b.Pesos[i]
+	}
+	pesos = genericos.Somar(b.Pesos...)
+	if pesos == 0 {
+		return 0, 0
+	}
+	return soma / pesos, pesos
+}
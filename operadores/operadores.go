@@ -1,210 +1,333 @@
 package operadores
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/calculadora"
+	"modulo/expressao"
+	"modulo/idioma"
+	"modulo/rastro"
+	"strings"
+)
 
-func OperadoresAritmeticos() {
-	fmt.Println("Operadores aritmeticos")
+// OperadoresAritmeticos faz contas em vários tipos com a calculadora, que
+// calcula no tipo escolhido exatamente como o Go e avisa quando o resultado
+// dá a volta (overflow), perde a parte fracionária ou é arredondado.
+func OperadoresAritmeticos(w io.Writer) {
+	r := rastro.De(w)
+
+	r.Secao(idioma.T("PRECEDÊNCIA: * / % antes de + -"))
+	calcular(r, "int", "2 + 3 * 4 - (1 + 1)")
+
+	r.Secao(idioma.T("DIVISÃO E RESTO DE INTEIROS"))
+	calcular(r, "int", "7 / 2")
+	calcular(r, "float64", "7 / 2")
+	calcular(r, "int", "-7 / 2")
+	calcular(r, "int", "-7 % 3")
+	calcular(r, "int", "7 % -3")
+	calcular(r, "int", "7 / 0")
+
+	r.Secao(idioma.T("OVERFLOW: O VALOR DÁ A VOLTA"))
+	calcular(r, "int8", "127 + 1")
+	calcular(r, "uint8", "0 - 1")
+	calcular(r, "uint8", "16 * 16")
+	calcular(r, "int8", "-128 / -1")
+
+	r.Secao(idioma.T("FLOAT: ARREDONDAMENTO, INFINITO E %"))
+	calcular(r, "float64", "0.1 + 0.2")
+	calcular(r, "float32", "0.1 + 0.2")
+	calcular(r, "float32", "1e38 * 10")
+	calcular(r, "float64", "1 / 0.0")
+	calcular(r, "float64", "7.5 % 2")
 }
 
-func OperadoresRelacionais() {
-	fmt.Println("Operadores relacionais")
+// OperadoresRelacionais compara valores que passaram por contas: o resultado
+// da comparação depende do tipo em que a conta foi feita.
+func OperadoresRelacionais(w io.Writer) {
+	r := rastro.De(w)
+
+	r.Secao(idioma.T("OPERADORES RELACIONAIS: == != < <= > >="))
+	calcular(r, "int", "7 > 5")
+	calcular(r, "int", "7 <= 5")
+	calcular(r, "int", "10 / 3 == 3")
+
+	r.Secao(idioma.T("A MESMA COMPARAÇÃO EM TIPOS DIFERENTES"))
+	calcular(r, "int", "200 + 100 > 250")
+	calcular(r, "uint8", "200 + 100 > 250")
+	calcular(r, "int8", "127 + 1 < 0")
+	calcular(r, "float64", "0.1 + 0.2 == 0.3")
+	calcular(r, "float32", "0.1 + 0.2 == 0.3")
 }
 
-func OperadoresLogicos() {
-	fmt.Println("\n=== OPERADOR && (AND) - Todas as combinações ===")
+// OperadoresBitABit mostra cada operação bit a bit com os operandos e o
+// resultado em binário e hexadecimal, alinhados bit com bit.
+func OperadoresBitABit(w io.Writer) {
+	r := rastro.De(w)
+
+	r.Secao(idioma.T("OPERADORES BIT A BIT: & | ^ &^"))
+	calcular(r, "uint8", "12 & 10")
+	calcular(r, "uint8", "12 | 10")
+	calcular(r, "uint8", "12 ^ 10")
+	calcular(r, "uint8", "12 &^ 10")
+	calcular(r, "uint8", "^12")
+	calcular(r, "int8", "^0")
+
+	r.Secao(idioma.T("DESLOCAMENTOS: << >>"))
+	calcular(r, "uint8", "1 << 3")
+	calcular(r, "uint8", "0xF0 >> 2")
+	calcular(r, "uint8", "200 << 1")
+	calcular(r, "int8", "-16 >> 2")
+	calcular(r, "uint8", "1 << 8")
+	calcular(r, "float64", "1 << 3")
+}
+
+// calcular mostra uma conta feita no tipo pedido, passo a passo.
+func calcular(r *rastro.Rastreador, tipo, texto string) {
+	conta, err := calculadora.Analisar(texto)
+	if err != nil {
+		panic(err)
+	}
+	r.Cenario(fmt.Sprintf(idioma.T("%s em %s"), texto, tipo))
+	resultado, err := conta.Calcular(tipo, r)
+	if err != nil {
+		r.Mensagem("❌ " + err.Error())
+		return
+	}
+	r.Decidir(resultado)
+}
+
+// As explicações passo a passo usam o rastro: no terminal elas aparecem como
+// texto e no playground podem ser mostradas como passos estruturados.
+func OperadoresLogicos(w io.Writer) {
+	r := rastro.De(w)
+	naoAvaliado := idioma.T("(não avaliado)")
+
+	r.Secao(idioma.T("OPERADOR && (AND) - Todas as combinações"))
 
 	// COMBINAÇÃO 1: true && true = true
-	fmt.Println("\n--- COMBINAÇÃO 1: true && true ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 1: true && true"))
 	num1 := 7
 	num2 := 5
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10")
-	fmt.Printf("         %d < 10 = %v\n", num1, num1 < 10)
-	fmt.Println("PASSO 3: true && true = true")
-	fmt.Println("RESULTADO: Executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Avaliar(idioma.T("BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10"), fmt.Sprintf("%d < 10", num1), num1 < 10)
+	r.Concluir("true && true", true)
+	r.Decidir(idioma.T("Executa o bloco if"))
 	if num1 > num2 && num1 < 10 {
-		fmt.Println("✅ num1 é maior que num2 E menor que 10")
+		fmt.Fprintln(w, idioma.T("✅ num1 é maior que num2 E menor que 10"))
 	}
 
 	// COMBINAÇÃO 2: true && false = false
-	fmt.Println("\n--- COMBINAÇÃO 2: true && false ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 2: true && false"))
 	num1 = 10
 	num2 = 5
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10")
-	fmt.Printf("         %d < 10 = %v\n", num1, num1 < 10)
-	fmt.Println("PASSO 3: true && false = false")
-	fmt.Println("RESULTADO: NÃO executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Avaliar(idioma.T("BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10"), fmt.Sprintf("%d < 10", num1), num1 < 10)
+	r.Concluir("true && false", false)
+	r.Decidir(idioma.T("NÃO executa o bloco if"))
 	if num1 > num2 && num1 < 10 {
-		fmt.Println("Esta mensagem não aparece")
+		fmt.Fprintln(w, idioma.T("Esta mensagem não aparece"))
 	} else {
-		fmt.Println("❌ num1 é maior que num2 MAS NÃO é menor que 10")
+		fmt.Fprintln(w, idioma.T("❌ num1 é maior que num2 MAS NÃO é menor que 10"))
 	}
 
 	// COMBINAÇÃO 3: false && true = false (curto-circuito)
-	fmt.Println("\n--- COMBINAÇÃO 3: false && true (CURTO-CIRCUITO) ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 3: false && true (CURTO-CIRCUITO)"))
 	num1 = 3
 	num2 = 5
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é false, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)")
-	fmt.Println("         O compilador para aqui e não verifica num1 < 10")
-	fmt.Println("PASSO 3: false && (não avaliado) = false")
-	fmt.Println("RESULTADO: NÃO executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Pular(idioma.T("BLOCO 1 é false, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)"), "num1 < 10")
+	r.Concluir("false && "+naoAvaliado, false)
+	r.Decidir(idioma.T("NÃO executa o bloco if"))
 	if num1 > num2 && num1 < 10 {
-		fmt.Println("Esta mensagem não aparece")
+		fmt.Fprintln(w, idioma.T("Esta mensagem não aparece"))
 	} else {
-		fmt.Println("❌ num1 NÃO é maior que num2 (BLOCO 2 não foi avaliado)")
+		fmt.Fprintln(w, idioma.T("❌ num1 NÃO é maior que num2 (BLOCO 2 não foi avaliado)"))
 	}
 
 	// COMBINAÇÃO 4: false && false = false (curto-circuito)
-	fmt.Println("\n--- COMBINAÇÃO 4: false && false (CURTO-CIRCUITO) ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 4: false && false (CURTO-CIRCUITO)"))
 	num1 = 3
 	num2 = 5
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é false, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)")
-	fmt.Println("         O compilador para aqui e não verifica num1 < 10")
-	fmt.Println("PASSO 3: false && (não avaliado) = false")
-	fmt.Println("RESULTADO: NÃO executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Pular(idioma.T("BLOCO 1 é false, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)"), "num1 < 10")
+	r.Concluir("false && "+naoAvaliado, false)
+	r.Decidir(idioma.T("NÃO executa o bloco if"))
 	if num1 > num2 && num1 < 10 {
-		fmt.Println("Esta mensagem não aparece")
+		fmt.Fprintln(w, idioma.T("Esta mensagem não aparece"))
 	} else {
-		fmt.Println("❌ num1 NÃO é maior que num2 (BLOCO 2 não foi avaliado)")
+		fmt.Fprintln(w, idioma.T("❌ num1 NÃO é maior que num2 (BLOCO 2 não foi avaliado)"))
 	}
 
-	fmt.Println("\n=== OPERADOR || (OR) - Todas as combinações ===")
+	r.Secao(idioma.T("OPERADOR || (OR) - Todas as combinações"))
 
 	// COMBINAÇÃO 1: true || true = true (curto-circuito)
-	fmt.Println("\n--- COMBINAÇÃO 1: true || true (CURTO-CIRCUITO) ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 1: true || true (CURTO-CIRCUITO)"))
 	num1 = 7
 	num2 = 5
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)")
-	fmt.Println("         O compilador para aqui e não verifica num1 < 10")
-	fmt.Println("PASSO 3: true || (não avaliado) = true")
-	fmt.Println("RESULTADO: Executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Pular(idioma.T("BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)"), "num1 < 10")
+	r.Concluir("true || "+naoAvaliado, true)
+	r.Decidir(idioma.T("Executa o bloco if"))
 	if num1 > num2 || num1 < 10 {
-		fmt.Println("✅ num1 é maior que num2 OU menor que 10 (BLOCO 2 não foi avaliado)")
+		fmt.Fprintln(w, idioma.T("✅ num1 é maior que num2 OU menor que 10 (BLOCO 2 não foi avaliado)"))
 	}
 
 	// COMBINAÇÃO 2: true || false = true (curto-circuito)
-	fmt.Println("\n--- COMBINAÇÃO 2: true || false (CURTO-CIRCUITO) ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 2: true || false (CURTO-CIRCUITO)"))
 	num1 = 10
 	num2 = 5
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)")
-	fmt.Println("         O compilador para aqui e não verifica num1 < 10")
-	fmt.Println("PASSO 3: true || (não avaliado) = true")
-	fmt.Println("RESULTADO: Executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Pular(idioma.T("BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO)"), "num1 < 10")
+	r.Concluir("true || "+naoAvaliado, true)
+	r.Decidir(idioma.T("Executa o bloco if"))
 	if num1 > num2 || num1 < 10 {
-		fmt.Println("✅ num1 é maior que num2 (BLOCO 2 não foi avaliado)")
+		fmt.Fprintln(w, idioma.T("✅ num1 é maior que num2 (BLOCO 2 não foi avaliado)"))
 	}
 
 	// COMBINAÇÃO 3: false || true = true
-	fmt.Println("\n--- COMBINAÇÃO 3: false || true ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 3: false || true"))
 	num1 = 3
 	num2 = 5
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é false, então avalia BLOCO 2 -> num1 < 10")
-	fmt.Printf("         %d < 10 = %v\n", num1, num1 < 10)
-	fmt.Println("PASSO 3: false || true = true")
-	fmt.Println("RESULTADO: Executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Avaliar(idioma.T("BLOCO 1 é false, então avalia BLOCO 2 -> num1 < 10"), fmt.Sprintf("%d < 10", num1), num1 < 10)
+	r.Concluir("false || true", true)
+	r.Decidir(idioma.T("Executa o bloco if"))
 	if num1 > num2 || num1 < 10 {
-		fmt.Println("✅ num1 NÃO é maior que num2 MAS é menor que 10")
+		fmt.Fprintln(w, idioma.T("✅ num1 NÃO é maior que num2 MAS é menor que 10"))
 	}
 
 	// COMBINAÇÃO 4: false || false = false
-	fmt.Println("\n--- COMBINAÇÃO 4: false || false ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 4: false || false"))
 	num1 = 15
 	num2 = 20
-	fmt.Printf("num1 = %d, num2 = %d\n", num1, num2)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é false, então avalia BLOCO 2 -> num1 < 10")
-	fmt.Printf("         %d < 10 = %v\n", num1, num1 < 10)
-	fmt.Println("PASSO 3: false || false = false")
-	fmt.Println("RESULTADO: NÃO executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d", num1, num2))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Avaliar(idioma.T("BLOCO 1 é false, então avalia BLOCO 2 -> num1 < 10"), fmt.Sprintf("%d < 10", num1), num1 < 10)
+	r.Concluir("false || false", false)
+	r.Decidir(idioma.T("NÃO executa o bloco if"))
 	if num1 > num2 || num1 < 10 {
-		fmt.Println("Esta mensagem não aparece")
+		fmt.Fprintln(w, idioma.T("Esta mensagem não aparece"))
 	} else {
-		fmt.Println("❌ num1 NÃO é maior que num2 E NÃO é menor que 10")
+		fmt.Fprintln(w, idioma.T("❌ num1 NÃO é maior que num2 E NÃO é menor que 10"))
 	}
 }
 
-func OperadoresLogicosTresCombinacoes() {
-	fmt.Println("\n=== OPERADORES LÓGICOS COM 3 COMBINAÇÕES ===")
+func OperadoresLogicosTresCombinacoes(w io.Writer) {
+	r := rastro.De(w)
+	naoAvaliado := idioma.T("(não avaliado)")
+
+	r.Secao(idioma.T("OPERADORES LÓGICOS COM 3 COMBINAÇÕES"))
 
 	// COMBINAÇÃO 1: (true && true) && true = true
-	fmt.Println("\n--- COMBINAÇÃO 1: (BLOCO1 && BLOCO2) && BLOCO3 = true ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 1: (BLOCO1 && BLOCO2) && BLOCO3 = true"))
 	num1 := 7
 	num2 := 5
 	num3 := 3
-	fmt.Printf("num1 = %d, num2 = %d, num3 = %d\n", num1, num2, num3)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10")
-	fmt.Printf("         %d < 10 = %v\n", num1, num1 < 10)
-	fmt.Println("PASSO 3: BLOCO 1 && BLOCO 2 = true && true = true")
-	fmt.Println("PASSO 4: (true) && BLOCO 3 -> num1 > num3")
-	fmt.Printf("         %d > %d = %v\n", num1, num3, num1 > num3)
-	fmt.Println("PASSO 5: true && true = true")
-	fmt.Println("RESULTADO: Executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d, num3 = %d", num1, num2, num3))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Avaliar(idioma.T("BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10"), fmt.Sprintf("%d < 10", num1), num1 < 10)
+	r.Concluir(idioma.T("BLOCO 1 && BLOCO 2")+" = true && true", true)
+	r.Avaliar(idioma.T("(true) && BLOCO 3 -> num1 > num3"), fmt.Sprintf("%d > %d", num1, num3), num1 > num3)
+	r.Concluir("true && true", true)
+	r.Decidir(idioma.T("Executa o bloco if"))
 	if (num1 > num2 && num1 < 10) && num1 > num3 {
-		fmt.Println("✅ Todas as condições são verdadeiras: num1 > num2 E num1 < 10 E num1 > num3")
+		fmt.Fprintln(w, idioma.T("✅ Todas as condições são verdadeiras: num1 > num2 E num1 < 10 E num1 > num3"))
 	}
 
 	// COMBINAÇÃO 2: (true && false) && true = false
-	fmt.Println("\n--- COMBINAÇÃO 2: (BLOCO1 && BLOCO2) && BLOCO3 = false ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 2: (BLOCO1 && BLOCO2) && BLOCO3 = false"))
 	num1 = 10
 	num2 = 5
 	num3 = 3
-	fmt.Printf("num1 = %d, num2 = %d, num3 = %d\n", num1, num2, num3)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10")
-	fmt.Printf("         %d < 10 = %v\n", num1, num1 < 10)
-	fmt.Println("PASSO 3: BLOCO 1 && BLOCO 2 = true && false = false")
-	fmt.Println("PASSO 4: (false) && BLOCO 3 -> NÃO avalia BLOCO 3 (CURTO-CIRCUITO)")
-	fmt.Println("         O compilador para aqui e não verifica num1 > num3")
-	fmt.Println("PASSO 5: false && (não avaliado) = false")
-	fmt.Println("RESULTADO: NÃO executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d, num3 = %d", num1, num2, num3))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Avaliar(idioma.T("BLOCO 1 é true, então avalia BLOCO 2 -> num1 < 10"), fmt.Sprintf("%d < 10", num1), num1 < 10)
+	r.Concluir(idioma.T("BLOCO 1 && BLOCO 2")+" = true && false", false)
+	r.Pular(idioma.T("(false) && BLOCO 3 -> NÃO avalia BLOCO 3 (CURTO-CIRCUITO)"), "num1 > num3")
+	r.Concluir("false && "+naoAvaliado, false)
+	r.Decidir(idioma.T("NÃO executa o bloco if"))
 	if (num1 > num2 && num1 < 10) && num1 > num3 {
-		fmt.Println("Esta mensagem não aparece")
+		fmt.Fprintln(w, idioma.T("Esta mensagem não aparece"))
 	} else {
-		fmt.Println("❌ BLOCO 1 && BLOCO 2 resultou em false, então BLOCO 3 não foi avaliado")
+		fmt.Fprintln(w, idioma.T("❌ BLOCO 1 && BLOCO 2 resultou em false, então BLOCO 3 não foi avaliado"))
 	}
 
 	// COMBINAÇÃO 3: (true || false) && true = true
-	fmt.Println("\n--- COMBINAÇÃO 3: (BLOCO1 || BLOCO2) && BLOCO3 = true ---")
+	r.Cenario(idioma.T("COMBINAÇÃO 3: (BLOCO1 || BLOCO2) && BLOCO3 = true"))
 	num1 = 10
 	num2 = 5
 	num3 = 3
-	fmt.Printf("num1 = %d, num2 = %d, num3 = %d\n", num1, num2, num3)
-	fmt.Println("PASSO 1: Avalia BLOCO 1 -> num1 > num2")
-	fmt.Printf("         %d > %d = %v\n", num1, num2, num1 > num2)
-	fmt.Println("PASSO 2: BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO do ||)")
-	fmt.Println("         O compilador para aqui e não verifica num1 < 10")
-	fmt.Println("PASSO 3: BLOCO 1 || (não avaliado) = true || (não avaliado) = true")
-	fmt.Println("PASSO 4: (true) && BLOCO 3 -> num1 > num3")
-	fmt.Printf("         %d > %d = %v\n", num1, num3, num1 > num3)
-	fmt.Println("PASSO 5: true && true = true")
-	fmt.Println("RESULTADO: Executa o bloco if")
+	r.Valores(fmt.Sprintf("num1 = %d, num2 = %d, num3 = %d", num1, num2, num3))
+	r.Avaliar(idioma.T("Avalia BLOCO 1 -> num1 > num2"), fmt.Sprintf("%d > %d", num1, num2), num1 > num2)
+	r.Pular(idioma.T("BLOCO 1 é true, então NÃO avalia BLOCO 2 (CURTO-CIRCUITO do ||)"), "num1 < 10")
+	r.Concluir(idioma.T("BLOCO 1")+" || "+naoAvaliado+" = true || "+naoAvaliado, true)
+	r.Avaliar(idioma.T("(true) && BLOCO 3 -> num1 > num3"), fmt.Sprintf("%d > %d", num1, num3), num1 > num3)
+	r.Concluir("true && true", true)
+	r.Decidir(idioma.T("Executa o bloco if"))
 	if (num1 > num2 || num1 < 10) && num1 > num3 {
-		fmt.Println("✅ (num1 > num2 OU num1 < 10) E num1 > num3 - BLOCO 2 não foi avaliado")
+		fmt.Fprintln(w, idioma.T("✅ (num1 > num2 OU num1 < 10) E num1 > num3 - BLOCO 2 não foi avaliado"))
+	}
+
+}
+
+// OperadoresLogicosExpressao avalia a mesma expressão com valores diferentes
+// usando o pacote expressao, que explica qualquer expressão digitada pelo aluno
+// (veja o comando "expressao" da aplicação de linha de comando).
+func OperadoresLogicosExpressao(w io.Writer) {
+	r := rastro.De(w)
+	e, err := expressao.Analisar("a > b && (c < 10 || !d)")
+	if err != nil {
+		panic(err)
 	}
 
+	r.Secao(fmt.Sprintf(idioma.T("EXPRESSÃO: %s"), e))
+	cenarios := []expressao.Variaveis{
+		{"a": 7, "b": 5, "c": 12, "d": false},
+		{"a": 7, "b": 5, "c": 3, "d": true},
+		{"a": 2, "b": 5, "c": 3, "d": false},
+	}
+	for i, vars := range cenarios {
+		r.Cenario(fmt.Sprintf(idioma.T("CENÁRIO %d"), i+1))
+		r.Valores(vars.String())
+		resultado, err := e.Avaliar(vars, r)
+		if err != nil {
+			panic(err)
+		}
+		r.Decidir(fmt.Sprint(resultado))
+	}
 }
 
+// OperadoresLogicosTabelaVerdade mostra a tabela verdade de && e || com os
+// trechos pulados pelo curto-circuito, e confere a lei de De Morgan comparando
+// as tabelas de duas expressões.
+func OperadoresLogicosTabelaVerdade(w io.Writer) {
+	formulas := [][]string{
+		{"a && b"},
+		{"a || b"},
+		{"a || !a"},
+		{"!(a && b)", "!a || !b"},
+	}
+	for _, textos := range formulas {
+		var expressoes []*expressao.Expressao
+		for _, texto := range textos {
+			e, err := expressao.Analisar(texto)
+			if err != nil {
+				panic(err)
+			}
+			expressoes = append(expressoes, e)
+		}
+		tabela, err := expressao.NovaTabela(expressoes...)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Fprintf(w, "\n=== %s ===\n", strings.Join(textos, " ≡ "))
+		tabela.Escrever(w)
+	}
+}
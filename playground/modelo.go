@@ -0,0 +1,92 @@
+package playground
+
+import "html/template"
+
+// Os links do navegador são absolutos porque as páginas usam <base> para que
+// os links relativos dos READMEs (ex.: README_SLICE.md) apontem para /docs/.
+var modelo = template.Must(template.New("pagina").Parse(`<!DOCTYPE html>
+<html lang="pt-BR">
+<head>
+<meta charset="utf-8">
+<title>{{.Titulo}} - Curso de Go</title>
+<base href="{{.Base}}">
+<style>
+body { margin: 0; display: flex; font-family: sans-serif; line-height: 1.5; }
+nav { width: 16rem; flex-shrink: 0; padding: 1rem; background: #f4f4f4; height: 100vh; overflow-y: auto; position: sticky; top: 0; box-sizing: border-box; }
+nav h3 { margin: 1rem 0 .25rem; font-size: .9rem; text-transform: uppercase; color: #555; }
+nav ul { list-style: none; margin: 0; padding: 0; }
+nav a { color: #00758d; text-decoration: none; font-size: .9rem; }
+nav a.atual { font-weight: bold; color: #000; }
+main { flex: 1; display: flex; gap: 1.5rem; padding: 1rem 2rem; min-width: 0; }
+.doc { flex: 1; min-width: 0; }
+.codigo { flex: 1; min-width: 0; }
+pre { background: #1e1e1e; color: #eee; padding: .75rem; overflow-x: auto; font-size: .85rem; }
+.doc pre { background: #f6f8fa; color: #000; }
+.saida pre { background: #002b36; color: #93a1a1; }
+table { border-collapse: collapse; }
+td, th { border: 1px solid #ddd; padding: .25rem .5rem; }
+.navegacao { display: flex; justify-content: space-between; margin-top: 2rem; }
+</style>
+</head>
+<body>
+<nav>
+<a href="/"{{if not .Atual}} class="atual"{{end}}>Início</a>
+{{- range .Curso}}
+<h3>{{.Nome}}</h3>
+<ul>
+{{- range .Topicos}}
+<li><a href="/topico/{{.ID}}"{{if eq .ID $.Atual}} class="atual"{{end}}>{{.Titulo}}</a></li>
+{{- end}}
+</ul>
+{{- end}}
+{{- if .Extras}}
+<h3>Outros documentos</h3>
+<ul>
+{{- range .Extras}}
+<li><a href="/docs/{{.}}"{{if eq . $.Atual}} class="atual"{{end}}>{{.}}</a></li>
+{{- end}}
+</ul>
+{{- end}}
+</nav>
+<main>
+<div class="doc">
+{{- if .Doc}}
+{{.Doc}}
+{{- else}}
+<h1>{{.Titulo}}</h1>
+<p>Este tópico ainda não tem README em docs/.</p>
+{{- end}}
+{{- if or .Anterior .Proximo}}
+<div class="navegacao">
+<span>{{with .Anterior}}<a href="/topico/{{.ID}}">← {{.Titulo}}</a>{{end}}</span>
+<span>{{with .Proximo}}<a href="/topico/{{.ID}}">{{.Titulo}} →</a>{{end}}</span>
+</div>
+{{- end}}
+</div>
+{{- if or .Fontes .Saidas}}
+<div class="codigo">
+{{- if .Saidas}}
+<h2>Saída das lições</h2>
+<p class="passos"><a href="/topico/{{.Atual}}/passos">passos em JSON</a></p>
+{{- range .Saidas}}
+<div class="saida">
+<h3>{{.Licao}}</h3>
+<pre>{{.Texto}}</pre>
+</div>
+{{- end}}
+{{- end}}
+{{- if .Fontes}}
+<h2>Código</h2>
+{{- range .Fontes}}
+<details open>
+<summary>{{.Arquivo}}</summary>
+<pre><code>{{.Codigo}}</code></pre>
+</details>
+{{- end}}
+{{- end}}
+</div>
+{{- end}}
+</main>
+</body>
+</html>
+`))
@@ -0,0 +1,293 @@
+package playground
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"log"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	agrupamento_modulos "modulo/agrupamento_modulos"
+	"modulo/progresso"
+	"modulo/rastro"
+
+	"github.com/russross/blackfriday/v2"
+)
+
+// Playground é o site local que mostra cada tópico do curso com o README,
+// o código das lições e a saída gerada ao executá-las.
+type Playground struct {
+	arquivos fs.FS
+	mux      *http.ServeMux
+
+	arquivoProgresso string
+	perfil           string
+}
+
+// Novo cria o site lendo README.md, docs/ e o código das lições de arquivos.
+// A raiz de arquivos deve ser a raiz do repositório.
+func Novo(arquivos fs.FS) *Playground {
+	p := &Playground{arquivos: arquivos, mux: http.NewServeMux()}
+	p.mux.HandleFunc("GET /{$}", p.inicio)
+	p.mux.HandleFunc("GET /topico/{id}", p.topico)
+	p.mux.HandleFunc("GET /topico/{id}/passos", p.passos)
+	p.mux.HandleFunc("GET /docs/{arquivo}", p.documento)
+	return p
+}
+
+// RegistrarProgresso faz cada página de tópico aberta marcar as suas lições
+// como vistas pelo perfil no arquivo de progresso informado.
+func (p *Playground) RegistrarProgresso(arquivo, perfil string) {
+	p.arquivoProgresso = arquivo
+	p.perfil = perfil
+}
+
+func (p *Playground) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mux.ServeHTTP(w, r)
+}
+
+// pagina é o que o modelo HTML recebe.
+type pagina struct {
+	Titulo   string
+	Base     string
+	Atual    string
+	Curso    []agrupamento_modulos.Grupo
+	Extras   []string
+	Doc      template.HTML
+	Fontes   []fonte
+	Saidas   []saida
+	Anterior *agrupamento_modulos.Topico
+	Proximo  *agrupamento_modulos.Topico
+}
+
+type fonte struct {
+	Arquivo string
+	Codigo  string
+}
+
+type saida struct {
+	Licao string
+	Texto string
+}
+
+func (p *Playground) inicio(w http.ResponseWriter, r *http.Request) {
+	doc, err := p.markdown("README.md")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	p.renderizar(w, pagina{Titulo: "Guia de Go", Base: "/", Doc: doc})
+}
+
+func (p *Playground) topico(w http.ResponseWriter, r *http.Request) {
+	topico, ok := agrupamento_modulos.BuscarTopico(r.PathValue("id"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	pag := pagina{Titulo: topico.Titulo, Base: "/docs/", Atual: topico.ID}
+	if topico.Doc != "" {
+		doc, err := p.markdown(path.Join("docs", topico.Doc))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		pag.Doc = doc
+	}
+	if topico.Pacote != "" {
+		fontes, err := p.fontes(topico.Pacote)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		pag.Fontes = fontes
+	}
+	for _, licao := range topico.Licoes {
+		pag.Saidas = append(pag.Saidas, saida{Licao: licao.Nome, Texto: executar(licao)})
+	}
+	pag.Anterior, pag.Proximo = vizinhos(topico.ID)
+	p.renderizar(w, pag)
+	p.marcarVisto(topico)
+}
+
+// licaoEmPassos é a execução de uma lição no formato JSON do rastro.
+type licaoEmPassos struct {
+	Licao  string         `json:"licao"`
+	Passos []rastro.Passo `json:"passos"`
+}
+
+// passos responde com os passos das lições do tópico, para o navegador
+// mostrar a execução passo a passo.
+func (p *Playground) passos(w http.ResponseWriter, r *http.Request) {
+	topico, ok := agrupamento_modulos.BuscarTopico(r.PathValue("id"))
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := EscreverPassos(&buf, topico); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	buf.WriteTo(w)
+}
+
+// EscreverPassos executa as lições do tópico e escreve em w, em JSON, os
+// passos de cada uma. É o formato da rota /topico/{id}/passos e do
+// comando licao --formato json.
+func EscreverPassos(w io.Writer, topico agrupamento_modulos.Topico) error {
+	licoes := []licaoEmPassos{}
+	for _, licao := range topico.Licoes {
+		licoes = append(licoes, licaoEmPassos{Licao: licao.Nome, Passos: executarEmPassos(licao)})
+	}
+	codificador := json.NewEncoder(w)
+	codificador.SetEscapeHTML(false)
+	codificador.SetIndent("", "  ")
+	return codificador.Encode(licoes)
+}
+
+func (p *Playground) marcarVisto(topico agrupamento_modulos.Topico) {
+	if p.arquivoProgresso == "" {
+		return
+	}
+	err := progresso.Registrar(p.arquivoProgresso, p.perfil, func(perfil *progresso.Perfil) {
+		for _, licao := range topico.Licoes {
+			perfil.MarcarLicao(topico.ID, licao.Nome, time.Now())
+		}
+	})
+	if err != nil {
+		log.Printf("não foi possível salvar o progresso: %v", err)
+	}
+}
+
+// documento mostra um arquivo de docs/. Se algum tópico usa o arquivo,
+// redireciona para a página do tópico (que também mostra código e saída).
+func (p *Playground) documento(w http.ResponseWriter, r *http.Request) {
+	arquivo := r.PathValue("arquivo")
+	for _, topico := range topicos() {
+		if topico.Doc == arquivo {
+			http.Redirect(w, r, "/topico/"+topico.ID, http.StatusFound)
+			return
+		}
+	}
+
+	doc, err := p.markdown(path.Join("docs", arquivo))
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	p.renderizar(w, pagina{Titulo: strings.TrimSuffix(arquivo, ".md"), Base: "/docs/", Atual: arquivo, Doc: doc})
+}
+
+func (p *Playground) renderizar(w http.ResponseWriter, pag pagina) {
+	pag.Curso = agrupamento_modulos.Curso()
+	pag.Extras = p.docsSemTopico()
+
+	var buf bytes.Buffer
+	if err := modelo.Execute(&buf, pag); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	buf.WriteTo(w)
+}
+
+func (p *Playground) markdown(arquivo string) (template.HTML, error) {
+	conteudo, err := fs.ReadFile(p.arquivos, arquivo)
+	if err != nil {
+		return "", err
+	}
+	return template.HTML(blackfriday.Run(conteudo)), nil
+}
+
+func (p *Playground) fontes(pacote string) ([]fonte, error) {
+	arquivos, err := fs.Glob(p.arquivos, path.Join(pacote, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var fontes []fonte
+	for _, arquivo := range arquivos {
+		if strings.HasSuffix(arquivo, "_test.go") {
+			continue
+		}
+		codigo, err := fs.ReadFile(p.arquivos, arquivo)
+		if err != nil {
+			return nil, err
+		}
+		fontes = append(fontes, fonte{Arquivo: arquivo, Codigo: string(codigo)})
+	}
+	return fontes, nil
+}
+
+// docsSemTopico lista os arquivos de docs/ que não pertencem a nenhum tópico.
+func (p *Playground) docsSemTopico() []string {
+	arquivos, _ := fs.Glob(p.arquivos, "docs/*.md")
+	var extras []string
+	for _, arquivo := range arquivos {
+		nome := path.Base(arquivo)
+		if !slices.ContainsFunc(topicos(), func(t agrupamento_modulos.Topico) bool { return t.Doc == nome }) {
+			extras = append(extras, nome)
+		}
+	}
+	return extras
+}
+
+// executar roda a lição e devolve a saída; um panic vira parte da saída
+// para que uma lição quebrada não derrube o servidor.
+func executar(licao agrupamento_modulos.Licao) (texto string) {
+	var buf bytes.Buffer
+	defer func() {
+		if r := recover(); r != nil {
+			texto = buf.String() + fmt.Sprintf("\npanic: %v\n", r)
+		}
+	}()
+	licao.Executar(&buf)
+	return buf.String()
+}
+
+// executarEmPassos é como executar, mas devolve os passos emitidos pela lição.
+func executarEmPassos(licao agrupamento_modulos.Licao) (passos []rastro.Passo) {
+	saida := rastro.NovoJSON(nil)
+	defer func() {
+		if r := recover(); r != nil {
+			passos = append(saida.Passos(), rastro.Passo{Tipo: rastro.Mensagem, Texto: fmt.Sprintf("panic: %v", r)})
+		}
+	}()
+	licao.Executar(saida)
+	return saida.Passos()
+}
+
+func topicos() []agrupamento_modulos.Topico {
+	var todos []agrupamento_modulos.Topico
+	for _, grupo := range agrupamento_modulos.Curso() {
+		todos = append(todos, grupo.Topicos...)
+	}
+	return todos
+}
+
+// vizinhos devolve o tópico anterior e o próximo na ordem do curso.
+func vizinhos(id string) (anterior, proximo *agrupamento_modulos.Topico) {
+	todos := topicos()
+	for i := range todos {
+		if todos[i].ID != id {
+			continue
+		}
+		if i > 0 {
+			anterior = &todos[i-1]
+		}
+		if i < len(todos)-1 {
+			proximo = &todos[i+1]
+		}
+	}
+	return anterior, proximo
+}
@@ -0,0 +1,146 @@
+package playground
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"modulo/progresso"
+	"modulo/rastro"
+)
+
+func get(t *testing.T, caminho string) (*http.Response, string) {
+	t.Helper()
+	servidor := httptest.NewServer(Novo(os.DirFS("..")))
+	defer servidor.Close()
+
+	cliente := servidor.Client()
+	cliente.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+	resposta, err := cliente.Get(servidor.URL + caminho)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resposta.Body.Close()
+	corpo, err := io.ReadAll(resposta.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resposta, string(corpo)
+}
+
+func TestInicio(t *testing.T) {
+	resposta, corpo := get(t, "/")
+	if resposta.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resposta.StatusCode)
+	}
+	// O navegador segue a ordem do curso.
+	modulos := strings.Index(corpo, `href="/topico/modificador_acesso"`)
+	slice := strings.Index(corpo, `href="/topico/slice"`)
+	interfaces := strings.Index(corpo, `href="/topico/interfaces"`)
+	if modulos < 0 || !(modulos < slice && slice < interfaces) {
+		t.Errorf("navegação fora da ordem do curso: %d %d %d", modulos, slice, interfaces)
+	}
+	if !strings.Contains(corpo, "<h1>Guia de Go (Golang)</h1>") {
+		t.Error("README.md não foi renderizado")
+	}
+}
+
+func TestTopico(t *testing.T) {
+	resposta, corpo := get(t, "/topico/slice")
+	if resposta.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resposta.StatusCode)
+	}
+	for _, trecho := range []string{
+		"<h1>SLICE</h1>",                        // README_SLICE.md em HTML
+		"slice/slice.go",                        // código da lição
+		"Append: [1 2 3 4 5 6]",                 // saída ao vivo da lição
+		`<a href="/topico/array">← ARRAY</a>`,   // tópico anterior
+		`<a href="/topico/ponteiro">PONTEIRO →`, // próximo tópico
+	} {
+		if !strings.Contains(corpo, trecho) {
+			t.Errorf("página não contém %q", trecho)
+		}
+	}
+}
+
+func TestDocumento(t *testing.T) {
+	resposta, _ := get(t, "/docs/README_SLICE.md")
+	if resposta.StatusCode != http.StatusFound || resposta.Header.Get("Location") != "/topico/slice" {
+		t.Errorf("esperava redirecionar para /topico/slice, obtido %d %s", resposta.StatusCode, resposta.Header.Get("Location"))
+	}
+
+	resposta, corpo := get(t, "/docs/README_RODAR_PROJETO.md")
+	if resposta.StatusCode != http.StatusOK || !strings.Contains(corpo, "<h1>RODAR PROJETO</h1>") {
+		t.Errorf("README_RODAR_PROJETO.md não foi renderizado (status %d)", resposta.StatusCode)
+	}
+
+	resposta, _ = get(t, "/docs/NAO_EXISTE.md")
+	if resposta.StatusCode != http.StatusNotFound {
+		t.Errorf("status = %d, esperado 404", resposta.StatusCode)
+	}
+}
+
+func TestTopicoInexistente(t *testing.T) {
+	resposta, _ := get(t, "/topico/nao-existe")
+	if resposta.StatusCode != http.StatusNotFound {
+		t.Errorf("status = %d, esperado 404", resposta.StatusCode)
+	}
+}
+
+func TestPassos(t *testing.T) {
+	resposta, corpo := get(t, "/topico/operadores/passos")
+	if resposta.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d", resposta.StatusCode)
+	}
+	var licoes []struct {
+		Licao  string
+		Passos []rastro.Passo
+	}
+	if err := json.Unmarshal([]byte(corpo), &licoes); err != nil {
+		t.Fatalf("resposta não é JSON: %v\n%s", err, corpo)
+	}
+	if len(licoes) == 0 {
+		t.Fatal("nenhuma lição")
+	}
+	pulos := 0
+	for _, licao := range licoes {
+		for _, passo := range licao.Passos {
+			if passo.Tipo == rastro.Pulo {
+				pulos++
+			}
+		}
+	}
+	if pulos == 0 {
+		t.Error("esperava passos de curto-circuito (pulo) nas lições de operadores lógicos")
+	}
+
+	resposta, _ = get(t, "/topico/nao-existe/passos")
+	if resposta.StatusCode != http.StatusNotFound {
+		t.Errorf("status = %d, esperado 404", resposta.StatusCode)
+	}
+}
+
+func TestTopicoRegistraProgresso(t *testing.T) {
+	caminho := filepath.Join(t.TempDir(), "progresso.json")
+	site := Novo(os.DirFS(".."))
+	site.RegistrarProgresso(caminho, "ana")
+
+	resposta := httptest.NewRecorder()
+	site.ServeHTTP(resposta, httptest.NewRequest("GET", "/topico/maps", nil))
+	if resposta.Code != http.StatusOK {
+		t.Fatalf("status = %d", resposta.Code)
+	}
+
+	p, err := progresso.Carregar(caminho)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := p.Perfil("ana").LicoesVistas["maps/Maps"]; !ok {
+		t.Errorf("lição maps/Maps não foi marcada como vista: %+v", p.Perfil("ana").LicoesVistas)
+	}
+}
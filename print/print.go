@@ -1,15 +1,18 @@
 package print
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
 
-func Print(){
+func Print(w io.Writer){
 	var(
 		nome string = "Mike"
         sobrenome string = "Marciano"
 		salario float32 = 100.20
 	)
-	fmt.Print("O nome é: " + nome + 
+	fmt.Fprint(w, "O nome é: " + nome + 
 	" sobrenome: " + sobrenome + 
 	" salario: " +
 	 fmt.Sprint(salario))
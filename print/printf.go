@@ -1,13 +1,16 @@
 package print
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func Printf() {
+func Printf(w io.Writer) {
 	var(
 		nome string = "Mike"
         sobrenome string = "Marciano"
 		salario float32 = 100.20
 	)
-	fmt.Printf("\nNome %s e sobrenome %s", nome, sobrenome)
-	fmt.Printf("\nSalario %f", salario)
+	fmt.Fprintf(w, "\nNome %s e sobrenome %s", nome, sobrenome)
+	fmt.Fprintf(w, "\nSalario %f", salario)
 }
\ No newline at end of file
@@ -1,12 +1,15 @@
 package print
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func Println() {
+func Println(w io.Writer) {
 	var(
 		nome string = "Mike"
         sobrenome string = "Marciano"
 		salario float32 = 100.20
 	)
-	fmt.Println("Println: O nome é: " + nome + " sobrenome: " + sobrenome + " salario: " + fmt.Sprint(salario))
+	fmt.Fprintln(w, "Println: O nome é: " + nome + " sobrenome: " + sobrenome + " salario: " + fmt.Sprint(salario))
 }
\ No newline at end of file
@@ -1,14 +1,17 @@
 package print
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+)
 
-func Sprint() {
+func Sprint(w io.Writer) {
 	var(
 		salario float32 = 100.20
 	)
 
 	valorSalario := fmt.Sprint(salario)
-	fmt.Println("O salario é " + valorSalario)
-	fmt.Println("O salario é ", salario)
+	fmt.Fprintln(w, "O salario é " + valorSalario)
+	fmt.Fprintln(w, "O salario é ", salario)
 
 }
\ No newline at end of file
@@ -0,0 +1,151 @@
+package progresso
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Perfil é o progresso de um aluno.
+// As chaves de LicoesVistas são "topico/licao" (ex.: "slice/Append")
+// e as de ExerciciosFeitos são os nomes dos exercícios.
+type Perfil struct {
+	LicoesVistas     map[string]time.Time `json:"licoes_vistas"`
+	ExerciciosFeitos map[string]time.Time `json:"exercicios_feitos"`
+	Quizzes          []Quiz               `json:"quizzes"`
+}
+
+// Quiz é o placar de uma rodada do quiz. Topico fica vazio quando
+// a rodada teve perguntas de todos os tópicos.
+type Quiz struct {
+	Topico  string    `json:"topico,omitempty"`
+	Acertos int       `json:"acertos"`
+	Total   int       `json:"total"`
+	Data    time.Time `json:"data"`
+}
+
+// Progresso guarda todos os perfis de um computador.
+type Progresso struct {
+	Perfis map[string]*Perfil `json:"perfis"`
+}
+
+// VariavelCaminho permite trocar o arquivo de progresso (útil em testes
+// e para guardar o progresso junto com o repositório).
+const VariavelCaminho = "CURSO_PROGRESSO"
+
+// CaminhoPadrao é o arquivo de progresso dentro do diretório de configuração
+// do usuário (ex.: ~/.config/curso-go/progresso.json no Linux), a não ser
+// que a variável de ambiente CURSO_PROGRESSO aponte para outro arquivo.
+func CaminhoPadrao() (string, error) {
+	if caminho := os.Getenv(VariavelCaminho); caminho != "" {
+		return caminho, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "curso-go", "progresso.json"), nil
+}
+
+// PerfilPadrao usa o nome do usuário do sistema quando nenhum perfil é informado.
+func PerfilPadrao() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return "aluno"
+}
+
+// Carregar lê o arquivo de progresso. Um arquivo que ainda não existe
+// devolve um progresso vazio.
+func Carregar(caminho string) (*Progresso, error) {
+	p := &Progresso{Perfis: map[string]*Perfil{}}
+	conteudo, err := os.ReadFile(caminho)
+	if errors.Is(err, fs.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(conteudo, p); err != nil {
+		return nil, err
+	}
+	if p.Perfis == nil {
+		p.Perfis = map[string]*Perfil{}
+	}
+	return p, nil
+}
+
+// Salvar grava o progresso em um arquivo temporário e depois o renomeia,
+// para não deixar um JSON pela metade se o programa for interrompido.
+func (p *Progresso) Salvar(caminho string) error {
+	if err := os.MkdirAll(filepath.Dir(caminho), 0o755); err != nil {
+		return err
+	}
+	conteudo, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+	temporario := caminho + ".tmp"
+	if err := os.WriteFile(temporario, conteudo, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(temporario, caminho)
+}
+
+// Perfil devolve o perfil com o nome informado, criando-o se necessário.
+func (p *Progresso) Perfil(nome string) *Perfil {
+	perfil, ok := p.Perfis[nome]
+	if !ok {
+		perfil = &Perfil{}
+		p.Perfis[nome] = perfil
+	}
+	if perfil.LicoesVistas == nil {
+		perfil.LicoesVistas = map[string]time.Time{}
+	}
+	if perfil.ExerciciosFeitos == nil {
+		perfil.ExerciciosFeitos = map[string]time.Time{}
+	}
+	return perfil
+}
+
+// MarcarLicao registra que a lição foi vista. A data da primeira vez é mantida.
+func (pf *Perfil) MarcarLicao(topico, licao string, quando time.Time) {
+	chave := topico + "/" + licao
+	if _, ok := pf.LicoesVistas[chave]; !ok {
+		pf.LicoesVistas[chave] = quando
+	}
+}
+
+// MarcarExercicio registra que o exercício passou em todos os casos.
+func (pf *Perfil) MarcarExercicio(nome string, quando time.Time) {
+	if _, ok := pf.ExerciciosFeitos[nome]; !ok {
+		pf.ExerciciosFeitos[nome] = quando
+	}
+}
+
+// RegistrarQuiz guarda o placar de uma rodada do quiz.
+func (pf *Perfil) RegistrarQuiz(topico string, acertos, total int, quando time.Time) {
+	pf.Quizzes = append(pf.Quizzes, Quiz{Topico: topico, Acertos: acertos, Total: total, Data: quando})
+}
+
+var mu sync.Mutex
+
+// Registrar carrega o arquivo, aplica alterar no perfil e salva de novo.
+// As chamadas dentro do mesmo processo (ex.: várias abas do playground)
+// são feitas uma de cada vez.
+func Registrar(caminho, perfil string, alterar func(*Perfil)) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	p, err := Carregar(caminho)
+	if err != nil {
+		return err
+	}
+	alterar(p.Perfil(perfil))
+	return p.Salvar(caminho)
+}
@@ -0,0 +1,112 @@
+package progresso
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCarregarArquivoInexistente(t *testing.T) {
+	p, err := Carregar(filepath.Join(t.TempDir(), "nao-existe.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Perfis) != 0 {
+		t.Errorf("esperado progresso vazio, obtido %d perfis", len(p.Perfis))
+	}
+}
+
+func TestRegistrarSalvaPorPerfil(t *testing.T) {
+	caminho := filepath.Join(t.TempDir(), "sub", "progresso.json")
+	quando := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	err := Registrar(caminho, "ana", func(p *Perfil) {
+		p.MarcarLicao("slice", "Append", quando)
+		p.MarcarExercicio("slice-inverter", quando)
+		p.RegistrarQuiz("slice", 2, 3, quando)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Ver de novo não muda a data da primeira vez.
+	err = Registrar(caminho, "ana", func(p *Perfil) {
+		p.MarcarLicao("slice", "Append", quando.Add(time.Hour))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Registrar(caminho, "bia", func(p *Perfil) { p.MarcarLicao("maps", "Maps", quando) }); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := Carregar(caminho)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ana := p.Perfil("ana")
+	if obtido := ana.LicoesVistas["slice/Append"]; !obtido.Equal(quando) {
+		t.Errorf("slice/Append visto em %v, esperado %v", obtido, quando)
+	}
+	if _, ok := ana.ExerciciosFeitos["slice-inverter"]; !ok {
+		t.Error("exercício slice-inverter não foi salvo")
+	}
+	if len(ana.Quizzes) != 1 || ana.Quizzes[0].Acertos != 2 {
+		t.Errorf("quizzes = %+v", ana.Quizzes)
+	}
+	if _, ok := ana.LicoesVistas["maps/Maps"]; ok {
+		t.Error("lição do perfil bia apareceu no perfil ana")
+	}
+	if _, err := os.Stat(caminho + ".tmp"); err == nil {
+		t.Error("arquivo temporário ficou para trás")
+	}
+}
+
+func TestMelhorQuiz(t *testing.T) {
+	pf := &Perfil{}
+	pf.RegistrarQuiz("slice", 1, 3, time.Time{})
+	pf.RegistrarQuiz("slice", 3, 4, time.Time{})
+	pf.RegistrarQuiz("slice", 2, 4, time.Time{})
+	pf.RegistrarQuiz("", 5, 11, time.Time{})
+
+	melhor, ok := pf.MelhorQuiz("slice")
+	if !ok || melhor.Acertos != 3 || melhor.Total != 4 {
+		t.Errorf("MelhorQuiz(slice) = %+v, %v", melhor, ok)
+	}
+	if _, ok := pf.MelhorQuiz("maps"); ok {
+		t.Error("MelhorQuiz(maps) não deveria achar rodadas")
+	}
+}
+
+func TestRelatorio(t *testing.T) {
+	p := &Progresso{Perfis: map[string]*Perfil{}}
+	pf := p.Perfil("ana")
+	pf.MarcarLicao("maps", "Maps", time.Time{})
+	pf.MarcarExercicio("map-inverter", time.Time{})
+	pf.RegistrarQuiz("maps", 1, 1, time.Time{})
+
+	var b strings.Builder
+	Relatorio(&b, "ana", pf)
+	saida := b.String()
+
+	for _, esperado := range []string{
+		"Progresso de ana",
+		"Estruturas de Dados",
+		"exercícios 1/2",
+		"quiz 1/1",
+		"Exercícios resolvidos: 1/8",
+	} {
+		if !strings.Contains(saida, esperado) {
+			t.Errorf("relatório sem %q:\n%s", esperado, saida)
+		}
+	}
+	for _, linha := range strings.Split(saida, "\n") {
+		if strings.Contains(linha, "MAPS") && !strings.HasPrefix(strings.TrimSpace(linha), "🔸") {
+			t.Errorf("tópico maps deveria estar em andamento: %q", linha)
+		}
+		if strings.Contains(linha, "SLICE") && !strings.HasPrefix(strings.TrimSpace(linha), "⬜") {
+			t.Errorf("tópico slice não deveria ter sido começado: %q", linha)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package progresso
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	agrupamento_modulos "modulo/agrupamento_modulos"
+	"modulo/exercicios"
+)
+
+// Relatorio escreve quanto do curso o perfil já completou, tópico a tópico,
+// seguindo os grupos de agrupamento_modulos.
+func Relatorio(w io.Writer, nome string, perfil *Perfil) {
+	exerciciosDoTopico := map[string][]string{}
+	for _, exercicio := range exercicios.Todos() {
+		exerciciosDoTopico[exercicio.Topico] = append(exerciciosDoTopico[exercicio.Topico], exercicio.Nome)
+	}
+
+	fmt.Fprintf(w, "Progresso de %s\n", nome)
+
+	// As colunas ficam alinhadas dentro de cada grupo.
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	var vistasTotal, licoesTotal, feitosTotal, exerciciosTotal int
+	for _, grupo := range agrupamento_modulos.Curso() {
+		fmt.Fprintf(tw, "\n%s\n", grupo.Nome)
+		for _, topico := range grupo.Topicos {
+			vistas := 0
+			for _, licao := range topico.Licoes {
+				if _, ok := perfil.LicoesVistas[topico.ID+"/"+licao.Nome]; ok {
+					vistas++
+				}
+			}
+			vistasTotal += vistas
+			licoesTotal += len(topico.Licoes)
+
+			linha := fmt.Sprintf("  %s %s\tlições %d/%d", marca(vistas, len(topico.Licoes)), topico.Titulo, vistas, len(topico.Licoes))
+			if nomes := exerciciosDoTopico[topico.ID]; len(nomes) > 0 {
+				feitos := 0
+				for _, nome := range nomes {
+					if _, ok := perfil.ExerciciosFeitos[nome]; ok {
+						feitos++
+					}
+				}
+				feitosTotal += feitos
+				exerciciosTotal += len(nomes)
+				linha += fmt.Sprintf("\texercícios %d/%d", feitos, len(nomes))
+			}
+			if melhor, ok := perfil.MelhorQuiz(topico.ID); ok {
+				linha += fmt.Sprintf("\tquiz %d/%d", melhor.Acertos, melhor.Total)
+			}
+			fmt.Fprintln(tw, linha)
+		}
+	}
+	tw.Flush()
+
+	fmt.Fprintf(w, "\nLições vistas: %d/%d (%d%%)\n", vistasTotal, licoesTotal, porcentagem(vistasTotal, licoesTotal))
+	fmt.Fprintf(w, "Exercícios resolvidos: %d/%d\n", feitosTotal, exerciciosTotal)
+	if melhor, ok := perfil.MelhorQuiz(""); ok {
+		fmt.Fprintf(w, "Melhor quiz geral: %d/%d\n", melhor.Acertos, melhor.Total)
+	}
+}
+
+// MelhorQuiz devolve a rodada com mais acertos do tópico
+// (use "" para as rodadas com perguntas de todos os tópicos).
+func (pf *Perfil) MelhorQuiz(topico string) (Quiz, bool) {
+	var melhor Quiz
+	achou := false
+	for _, q := range pf.Quizzes {
+		if q.Topico != topico {
+			continue
+		}
+		if !achou || porcentagem(q.Acertos, q.Total) > porcentagem(melhor.Acertos, melhor.Total) {
+			melhor, achou = q, true
+		}
+	}
+	return melhor, achou
+}
+
+func marca(feitas, total int) string {
+	switch {
+	case total > 0 && feitas == total:
+		return "✅"
+	case feitas > 0:
+		return "🔸"
+	}
+	return "⬜"
+}
+
+func porcentagem(parte, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return parte * 100 / total
+}
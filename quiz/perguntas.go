@@ -0,0 +1,123 @@
+package quiz
+
+import (
+	"regexp"
+	"strings"
+)
+
+var perguntas = []Pergunta{
+	{
+		Topico:     "array",
+		Licao:      "InicializacaoComIndicesEspecificos",
+		Enunciado:  "O que array.InicializacaoComIndicesEspecificos imprime para [5]string{1: \"Sophia\", 3: \"Eduardo\"}?",
+		Opcoes:     []string{"[Sophia Eduardo]", "[ Sophia  Eduardo ]", "[nil Sophia nil Eduardo nil]", "[Sophia Eduardo   ]"},
+		Resposta:   saidaCompleta,
+		Explicacao: "Os índices não informados recebem o zero value de string (\"\"), que o fmt imprime como vazio entre os espaços.",
+	},
+	{
+		Topico:     "slice",
+		Licao:      "AppendMultiplos",
+		Enunciado:  "Preveja a saída: qual slice slice.AppendMultiplos imprime depois de append(slice, 6, 7, 8, 9, 10)?",
+		Resposta:   entreColchetes,
+		Explicacao: "append aceita vários valores de uma vez e devolve o slice com todos eles no final.",
+	},
+	{
+		Topico:     "slice",
+		Licao:      "RemoverItemPorIndice",
+		Enunciado:  "Depois de append(slice[:2], slice[3:]...) em um slice de 5 itens, qual capacidade (cap) slice.RemoverItemPorIndice imprime?",
+		Opcoes:     []string{"3", "4", "5", "6"},
+		Resposta:   ultimoNumero,
+		Explicacao: "Remover com append reaproveita o array original: o tamanho cai para 4, mas a capacidade continua 5.",
+	},
+	{
+		Topico:     "slice",
+		Licao:      "CriarSliceComMakeTamanhoECapacidadeDiferentes",
+		Enunciado:  "Preveja a saída: qual o tamanho (len) de make([]int, 3, 10)?",
+		Resposta:   penultimoNumero,
+		Explicacao: "O segundo argumento do make é o tamanho (len) e o terceiro é a capacidade (cap).",
+	},
+	{
+		Topico:     "ponteiro",
+		Licao:      "AtribuiValorParaVariavel",
+		Enunciado:  "variavel2 := variavel1 e depois variavel1++. Qual o valor final de variavel2?",
+		Opcoes:     []string{"10", "11", "0", "depende do endereço de memória"},
+		Resposta:   ultimoNumero,
+		Explicacao: "A atribuição copia o valor: alterar variavel1 depois não muda variavel2.",
+	},
+	{
+		Topico:     "ponteiro",
+		Licao:      "DiferencaEntrePonteiroEValor",
+		Enunciado:  "Preveja a saída: ponteiro = &variavel1 e depois variavel1++ (variavel1 começa em 10). Qual o valor de *ponteiro?",
+		Resposta:   ultimoNumero,
+		Explicacao: "O ponteiro guarda o endereço de variavel1, então *ponteiro sempre lê o valor atual dela.",
+	},
+	{
+		Topico:     "maps",
+		Licao:      "DeletarItemDoMap",
+		Enunciado:  "O que maps.DeletarItemDoMap imprime depois de delete(dados, \"nome\")?",
+		Opcoes:     []string{"map[email:mike@example.com idade:30 nome:Mike]", "map[email:mike@example.com idade:30 nome:]", "map[email:mike@example.com idade:30]", "map[]"},
+		Resposta:   saidaCompleta,
+		Explicacao: "delete remove a chave do map; ela não fica com valor vazio. O fmt imprime as chaves de um map em ordem alfabética.",
+	},
+	{
+		Topico:     "ifelse",
+		Licao:      "IfElseInicializandoVariavel",
+		Enunciado:  "Em if idade := numero; idade >= 18, com numero = 12, qual mensagem aparece?",
+		Opcoes:     []string{"Você é maior de idade", "Você é menor de idade", "Nada é impresso", "Erro de compilação"},
+		Resposta:   saidaCompleta,
+		Explicacao: "A variável declarada no if existe só dentro do if/else; como 12 < 18 o else é executado.",
+	},
+	{
+		Topico:     "switchs",
+		Licao:      "SwitchComRetorno",
+		Enunciado:  "switchs.SwitchComRetorno usa numero = 12. O que ela devolve?",
+		Opcoes:     []string{"Domingo", "Sábado", "Dia inválido", "Segunda-feira"},
+		Resposta:   saidaCompleta,
+		Explicacao: "Nenhum case vai até 12, então o default é executado.",
+	},
+	{
+		Topico:     "funcoes_avancadas",
+		Licao:      "FuncaoRecursiva",
+		Enunciado:  "Preveja a saída: qual o valor de funcoes.FuncaoRecursiva(15) (Fibonacci)?",
+		Resposta:   ultimoNumero,
+		Explicacao: "A sequência começa 0, 1, 1, 2, 3, 5, 8... e a posição 15 é 610.",
+	},
+	{
+		Topico:     "funcoes_avancadas",
+		Licao:      "FuncaoPonteiro",
+		Enunciado:  "numero := 10 e funcoes.FuncaoPonteiro(w, &numero) faz *numero = *numero * -1. Qual o valor de numero depois da chamada?",
+		Opcoes:     []string{"10", "-10", "0", "-1"},
+		Resposta:   ultimoNumero,
+		Explicacao: "A função recebeu o endereço de numero, então a alteração vale fora dela também.",
+	},
+}
+
+var numero = regexp.MustCompile(`-?\d+`)
+
+func saidaCompleta(saida string) string {
+	return strings.TrimSpace(saida)
+}
+
+func entreColchetes(saida string) string {
+	inicio, fim := strings.LastIndex(saida, "["), strings.LastIndex(saida, "]")
+	if inicio < 0 || fim < inicio {
+		return ""
+	}
+	return saida[inicio : fim+1]
+}
+
+func ultimoNumero(saida string) string {
+	numeros := numero.FindAllString(saida, -1)
+	if len(numeros) == 0 {
+		return ""
+	}
+	return numeros[len(numeros)-1]
+}
+
+func penultimoNumero(saida string) string {
+	numeros := numero.FindAllString(saida, -1)
+	if len(numeros) < 2 {
+		return ""
+	}
+	return numeros[len(numeros)-2]
+}
@@ -0,0 +1,141 @@
+package quiz
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	agrupamento_modulos "modulo/agrupamento_modulos"
+	"modulo/idioma"
+)
+
+// Pergunta é ligada a uma lição real do curso: a resposta correta é extraída
+// da saída da lição, então a pergunta acompanha qualquer mudança no código.
+// Quando Opcoes está vazio a pergunta é de "prever a saída" (resposta livre).
+type Pergunta struct {
+	Topico     string
+	Licao      string
+	Enunciado  string
+	Opcoes     []string
+	Resposta   func(saida string) string
+	Explicacao string
+}
+
+// Placar guarda o resultado de um quiz.
+type Placar struct {
+	Acertos int
+	Total   int
+}
+
+// Todas devolve as perguntas do quiz, na ordem do curso.
+func Todas() []Pergunta {
+	return perguntas
+}
+
+// DoTopico devolve apenas as perguntas de um tópico.
+func DoTopico(topico string) []Pergunta {
+	var filtradas []Pergunta
+	for _, pergunta := range perguntas {
+		if pergunta.Topico == topico {
+			filtradas = append(filtradas, pergunta)
+		}
+	}
+	return filtradas
+}
+
+// ExecutarLicao roda a lição da pergunta e devolve o que ela escreveu.
+func (p Pergunta) ExecutarLicao() (string, error) {
+	licao, ok := agrupamento_modulos.BuscarLicao(p.Topico, p.Licao)
+	if !ok {
+		return "", fmt.Errorf(idioma.T("lição %s/%s não encontrada"), p.Topico, p.Licao)
+	}
+	var buf bytes.Buffer
+	licao.Executar(&buf)
+	return buf.String(), nil
+}
+
+// OpcoesTraduzidas devolve as opções no idioma atual. As opções que são
+// saídas de lições precisam acompanhar o idioma em que a lição escreve.
+func (p Pergunta) OpcoesTraduzidas() []string {
+	opcoes := make([]string, len(p.Opcoes))
+	for i, opcao := range p.Opcoes {
+		opcoes[i] = idioma.T(opcao)
+	}
+	return opcoes
+}
+
+// RespostaCorreta executa a lição e extrai dela a resposta certa.
+func (p Pergunta) RespostaCorreta() (string, error) {
+	saida, err := p.ExecutarLicao()
+	if err != nil {
+		return "", err
+	}
+	return p.Resposta(saida), nil
+}
+
+// Aplicar faz as perguntas lendo as respostas de entrada e escrevendo em saida,
+// no idioma atual: Enunciado e Explicacao são traduzidos com idioma.T.
+// Depois de cada resposta a lição é executada para explicar a resposta correta.
+// Se a entrada terminar antes do fim, o placar conta só as perguntas respondidas.
+func Aplicar(entrada io.Reader, saida io.Writer, perguntas []Pergunta) (Placar, error) {
+	var placar Placar
+	leitor := bufio.NewScanner(entrada)
+
+	for i, pergunta := range perguntas {
+		saidaLicao, err := pergunta.ExecutarLicao()
+		if err != nil {
+			return placar, err
+		}
+		correta := pergunta.Resposta(saidaLicao)
+
+		fmt.Fprintf(saida, idioma.T("\nPergunta %d/%d [%s]\n"), i+1, len(perguntas), pergunta.Topico)
+		fmt.Fprintln(saida, idioma.T(pergunta.Enunciado))
+		for n, opcao := range pergunta.OpcoesTraduzidas() {
+			fmt.Fprintf(saida, "  %d) %s\n", n+1, opcao)
+		}
+		fmt.Fprint(saida, "> ")
+		if !leitor.Scan() {
+			fmt.Fprintln(saida)
+			break
+		}
+		placar.Total++
+
+		if acertou(pergunta, leitor.Text(), correta) {
+			placar.Acertos++
+			fmt.Fprintln(saida, idioma.T("✅ Correto!"))
+		} else {
+			fmt.Fprintln(saida, idioma.T("❌ Resposta correta:"), correta)
+		}
+		fmt.Fprintf(saida, idioma.T("--- saída de %s.%s ---\n"), pergunta.Topico, pergunta.Licao)
+		fmt.Fprint(saida, saidaLicao)
+		if !strings.HasSuffix(saidaLicao, "\n") {
+			fmt.Fprintln(saida)
+		}
+		fmt.Fprintln(saida, "---")
+		fmt.Fprintln(saida, idioma.T(pergunta.Explicacao))
+	}
+
+	fmt.Fprintf(saida, idioma.T("\nPlacar: %d/%d\n"), placar.Acertos, placar.Total)
+	return placar, leitor.Err()
+}
+
+// acertou aceita o número da opção ou o texto da opção nas perguntas de
+// múltipla escolha, e ignora espaços extras e maiúsculas na resposta livre.
+func acertou(pergunta Pergunta, resposta, correta string) bool {
+	resposta = strings.TrimSpace(resposta)
+	opcoes := pergunta.OpcoesTraduzidas()
+	if n, err := strconv.Atoi(resposta); err == nil && len(opcoes) > 0 {
+		if n < 1 || n > len(opcoes) {
+			return false
+		}
+		resposta = opcoes[n-1]
+	}
+	return normalizar(resposta) == normalizar(correta)
+}
+
+func normalizar(texto string) string {
+	return strings.ToLower(strings.Join(strings.Fields(texto), " "))
+}
@@ -0,0 +1,105 @@
+package quiz
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"modulo/idioma"
+)
+
+// A resposta de cada pergunta vem da saída da lição. Se uma lição mudar,
+// este teste avisa quando a resposta deixou de existir entre as opções.
+func TestRespostasCorretasExistem(t *testing.T) {
+	for _, i := range []idioma.Idioma{idioma.Portugues, idioma.Ingles} {
+		t.Run(string(i), func(t *testing.T) {
+			idioma.Definir(i)
+			defer idioma.Definir(idioma.Portugues)
+			verificarRespostas(t)
+		})
+	}
+}
+
+func verificarRespostas(t *testing.T) {
+	for _, pergunta := range Todas() {
+		t.Run(pergunta.Topico+"/"+pergunta.Licao, func(t *testing.T) {
+			correta, err := pergunta.RespostaCorreta()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if correta == "" {
+				t.Fatal("não foi possível extrair a resposta da saída da lição")
+			}
+			opcoes := pergunta.OpcoesTraduzidas()
+			if len(opcoes) > 0 && !slices.Contains(opcoes, correta) {
+				t.Errorf("resposta %q não está entre as opções %q", correta, opcoes)
+			}
+		})
+	}
+}
+
+func TestAplicar(t *testing.T) {
+	perguntas := DoTopico("slice")
+	if len(perguntas) != 3 {
+		t.Fatalf("esperava 3 perguntas de slice, obtido %d", len(perguntas))
+	}
+
+	// 1ª resposta livre com espaços extras, 2ª opção errada, 3ª resposta livre certa.
+	entrada := strings.NewReader("  [1 2 3 4 5 6 7 8 9   10] \n1\n3\n")
+	var saida strings.Builder
+	placar, err := Aplicar(entrada, &saida, perguntas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if placar != (Placar{Acertos: 2, Total: 3}) {
+		t.Errorf("placar = %+v, esperado 2/3\n%s", placar, saida.String())
+	}
+	for _, trecho := range []string{"❌ Resposta correta: 5", "--- saída de slice.RemoverItemPorIndice ---", "Placar: 2/3"} {
+		if !strings.Contains(saida.String(), trecho) {
+			t.Errorf("saída não contém %q:\n%s", trecho, saida.String())
+		}
+	}
+}
+
+func TestAplicarEntradaTerminaAntes(t *testing.T) {
+	placar, err := Aplicar(strings.NewReader("2\n"), &strings.Builder{}, DoTopico("ponteiro"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if placar != (Placar{Acertos: 0, Total: 1}) {
+		t.Errorf("placar = %+v, esperado 0/1", placar)
+	}
+}
+
+// O catálogo só confere idioma.T com literais; Enunciado e Explicacao são
+// traduzidos em Aplicar, então este teste confere as traduções deles.
+func TestPerguntasTraduzidas(t *testing.T) {
+	idioma.Definir(idioma.Ingles)
+	defer idioma.Definir(idioma.Portugues)
+	for _, pergunta := range Todas() {
+		for _, texto := range []string{pergunta.Enunciado, pergunta.Explicacao} {
+			if idioma.T(texto) == texto {
+				t.Errorf("%s/%s: %q não tem tradução em idioma/ingles.go", pergunta.Topico, pergunta.Licao, texto)
+			}
+		}
+	}
+}
+
+func TestAplicarEmIngles(t *testing.T) {
+	idioma.Definir(idioma.Ingles)
+	defer idioma.Definir(idioma.Portugues)
+	var saida strings.Builder
+	if _, err := Aplicar(strings.NewReader("3\n"), &saida, DoTopico("slice")[:1]); err != nil {
+		t.Fatal(err)
+	}
+	for _, trecho := range []string{"Question 1/1", "Predict the output", "--- output of slice.AppendMultiplos ---", "append accepts", "Score: 0/1"} {
+		if !strings.Contains(saida.String(), trecho) {
+			t.Errorf("saída não contém %q:\n%s", trecho, saida.String())
+		}
+	}
+	for _, trecho := range []string{"Pergunta", "Preveja", "Placar", "saída de"} {
+		if strings.Contains(saida.String(), trecho) {
+			t.Errorf("saída em inglês contém %q:\n%s", trecho, saida.String())
+		}
+	}
+}
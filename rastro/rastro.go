@@ -0,0 +1,154 @@
+package rastro
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Tipo diz o que um passo representa.
+type Tipo int
+
+const (
+	// Secao abre um bloco da lição (ex.: "OPERADOR && (AND)").
+	Secao Tipo = iota
+	// Cenario é um caso dentro da seção; a numeração dos passos recomeça.
+	Cenario
+	// Valores mostra as variáveis usadas no cenário (ex.: "num1 = 7, num2 = 5").
+	Valores
+	// Avaliacao é uma expressão que foi avaliada e o valor obtido.
+	Avaliacao
+	// Pulo é uma expressão que não foi avaliada por causa do curto-circuito.
+	Pulo
+	// Conclusao combina os resultados anteriores (ex.: "true && false = false").
+	Conclusao
+	// Decisao é o caminho que o programa tomou (ex.: "Executa o bloco if").
+	Decisao
+	// Mensagem é texto comum escrito pela lição.
+	Mensagem
+	// Aviso chama a atenção para algo que o passo anterior escondeu
+	// (ex.: um overflow ou um arredondamento).
+	Aviso
+	// Detalhe mostra informações extras sobre o passo anterior, uma por linha
+	// (ex.: os bits de cada operando).
+	Detalhe
+)
+
+var nomesDosTipos = [...]string{"secao", "cenario", "valores", "avaliacao", "pulo", "conclusao", "decisao", "mensagem", "aviso", "detalhe"}
+
+func (t Tipo) String() string {
+	if int(t) < len(nomesDosTipos) {
+		return nomesDosTipos[t]
+	}
+	return fmt.Sprintf("Tipo(%d)", int(t))
+}
+
+// MarshalText faz o tipo aparecer pelo nome no JSON.
+func (t Tipo) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText lê o nome gravado por MarshalText.
+func (t *Tipo) UnmarshalText(texto []byte) error {
+	for i, nome := range nomesDosTipos {
+		if nome == string(texto) {
+			*t = Tipo(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("tipo de passo desconhecido: %q", texto)
+}
+
+// Passo é um acontecimento da execução de uma lição.
+// Numero só é preenchido nos passos numerados (avaliação, pulo e conclusão).
+type Passo struct {
+	Tipo      Tipo   `json:"tipo"`
+	Numero    int    `json:"numero,omitempty"`
+	Texto     string `json:"texto,omitempty"`
+	Expressao string `json:"expressao,omitempty"`
+	Valor     string `json:"valor,omitempty"`
+}
+
+// Destino recebe os passos emitidos pelas lições. Os renderizadores deste
+// pacote são destinos e também io.Writer, para receber o texto comum.
+type Destino interface {
+	Passo(p Passo)
+}
+
+// Rastreador é usado pelas lições para emitir passos.
+type Rastreador struct {
+	destino Destino
+	numero  int
+}
+
+// De cria um rastreador para o writer que a lição recebeu. Se o writer for
+// um Destino (ex.: o renderizador JSON do playground) os passos vão para ele;
+// senão eles são escritos como texto simples.
+func De(w io.Writer) *Rastreador {
+	if destino, ok := w.(Destino); ok {
+		return &Rastreador{destino: destino}
+	}
+	return &Rastreador{destino: NovoTexto(w)}
+}
+
+func (r *Rastreador) emitir(p Passo) {
+	r.destino.Passo(p)
+}
+
+func (r *Rastreador) numerado(p Passo) {
+	r.numero++
+	p.Numero = r.numero
+	r.emitir(p)
+}
+
+// Secao abre um bloco da lição.
+func (r *Rastreador) Secao(titulo string) {
+	r.emitir(Passo{Tipo: Secao, Texto: titulo})
+}
+
+// Cenario começa um caso novo e recomeça a numeração dos passos.
+func (r *Rastreador) Cenario(titulo string) {
+	r.numero = 0
+	r.emitir(Passo{Tipo: Cenario, Texto: titulo})
+}
+
+// Valores mostra as variáveis do cenário.
+func (r *Rastreador) Valores(texto string) {
+	r.emitir(Passo{Tipo: Valores, Texto: texto})
+}
+
+// Avaliar registra uma expressão avaliada: texto explica o passo, expressao
+// é a expressão já com os valores (ex.: "7 > 5") e valor é o resultado.
+func (r *Rastreador) Avaliar(texto, expressao string, valor any) {
+	r.numerado(Passo{Tipo: Avaliacao, Texto: texto, Expressao: expressao, Valor: fmt.Sprint(valor)})
+}
+
+// Pular registra uma expressão que não foi avaliada (curto-circuito).
+func (r *Rastreador) Pular(texto, expressao string) {
+	r.numerado(Passo{Tipo: Pulo, Texto: texto, Expressao: expressao})
+}
+
+// Concluir registra a combinação dos resultados anteriores.
+func (r *Rastreador) Concluir(expressao string, valor any) {
+	r.numerado(Passo{Tipo: Conclusao, Expressao: expressao, Valor: fmt.Sprint(valor)})
+}
+
+// Decidir registra o caminho que o programa tomou.
+func (r *Rastreador) Decidir(texto string) {
+	r.emitir(Passo{Tipo: Decisao, Texto: texto})
+}
+
+// Avisar registra um aviso sobre o passo anterior.
+func (r *Rastreador) Avisar(texto string) {
+	r.emitir(Passo{Tipo: Aviso, Texto: texto})
+}
+
+// Detalhar registra linhas extras sobre o passo anterior.
+func (r *Rastreador) Detalhar(linhas ...string) {
+	r.emitir(Passo{Tipo: Detalhe, Texto: strings.Join(linhas, "\n")})
+}
+
+// Mensagem registra texto comum.
+func (r *Rastreador) Mensagem(texto string) {
+	r.emitir(Passo{Tipo: Mensagem, Texto: texto})
+}
@@ -0,0 +1,132 @@
+package rastro
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// licao emite um cenário de curto-circuito completo, como as lições fazem.
+func licao(r *Rastreador) {
+	r.Secao("OPERADOR &&")
+	r.Cenario("CENARIO 1")
+	r.Valores("a = 1")
+	r.Avaliar("BLOCO 1", "1 > 2", false)
+	r.Pular("BLOCO 2", "a > 0")
+	r.Concluir("false && ?", false)
+	r.Decidir("NÃO executa o bloco if")
+	r.Cenario("CENARIO 2")
+	r.Avaliar("BLOCO 1", "1 > 0", true)
+	r.Avisar("cuidado")
+	r.Detalhar("  0001", "= 0010")
+}
+
+func TestTexto(t *testing.T) {
+	var buf bytes.Buffer
+	licao(De(&buf))
+
+	esperado := `
+=== OPERADOR && ===
+
+--- CENARIO 1 ---
+a = 1
+PASSO 1: BLOCO 1
+         1 > 2 = false
+PASSO 2: BLOCO 2
+         O compilador para aqui e não verifica a > 0
+PASSO 3: false && ? = false
+RESULTADO: NÃO executa o bloco if
+
+--- CENARIO 2 ---
+PASSO 1: BLOCO 1
+         1 > 0 = true
+         ⚠ cuidado
+           0001
+         = 0010
+`
+	if buf.String() != esperado {
+		t.Errorf("saída:\n%s\nesperado:\n%s", buf.String(), esperado)
+	}
+}
+
+func TestColorido(t *testing.T) {
+	var buf bytes.Buffer
+	licao(De(NovoColorido(&buf)))
+
+	saida := buf.String()
+	for _, trecho := range []string{
+		string(verde) + "true" + restaurar,
+		string(vermelho) + "false" + restaurar,
+		string(amarelo) + "BLOCO 2" + restaurar,
+		string(amarelo) + "⚠ cuidado" + restaurar,
+	} {
+		if !strings.Contains(saida, trecho) {
+			t.Errorf("saída não contém %q:\n%s", trecho, saida)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var buf bytes.Buffer
+	saida := NovoJSON(&buf)
+	fmt.Fprint(saida, "OPERADORES: ")
+	fmt.Fprint(saida, "inicio\n\n")
+	licao(De(saida))
+	fmt.Fprint(saida, "fim sem quebra de linha")
+	if err := saida.Finalizar(); err != nil {
+		t.Fatal(err)
+	}
+
+	var passos []Passo
+	if err := json.Unmarshal(buf.Bytes(), &passos); err != nil {
+		t.Fatalf("JSON inválido: %v\n%s", err, buf.String())
+	}
+	var tipos []Tipo
+	for _, p := range passos {
+		tipos = append(tipos, p.Tipo)
+	}
+	esperado := []Tipo{Mensagem, Secao, Cenario, Valores, Avaliacao, Pulo, Conclusao, Decisao, Cenario, Avaliacao, Aviso, Detalhe, Mensagem}
+	if !slices.Equal(tipos, esperado) {
+		t.Errorf("tipos = %v\nesperado %v", tipos, esperado)
+	}
+	if passos[0].Texto != "OPERADORES: inicio" {
+		t.Errorf("texto partido em dois writes = %q", passos[0].Texto)
+	}
+	if p := passos[5]; p.Numero != 2 || p.Expressao != "a > 0" || p.Valor != "" {
+		t.Errorf("passo de pulo = %+v", p)
+	}
+	if !strings.Contains(buf.String(), `"tipo": "avaliacao"`) {
+		t.Errorf("tipo não foi gravado pelo nome:\n%s", buf.String())
+	}
+}
+
+func TestJSONSemPassos(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NovoJSON(&buf).Finalizar(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("saída = %q, esperado []", buf.String())
+	}
+}
+
+func TestNovaSaida(t *testing.T) {
+	for _, formato := range []string{"", FormatoTexto, FormatoCores, FormatoJSON} {
+		if _, err := NovaSaida(formato, &bytes.Buffer{}); err != nil {
+			t.Errorf("NovaSaida(%q): %v", formato, err)
+		}
+	}
+	if _, err := NovaSaida("xml", &bytes.Buffer{}); err == nil {
+		t.Error("esperava erro para formato xml")
+	}
+}
+
+func TestTipoDesconhecido(t *testing.T) {
+	var tipo Tipo
+	if err := tipo.UnmarshalText([]byte("outro")); err == nil {
+		t.Error("esperava erro para tipo desconhecido")
+	}
+}
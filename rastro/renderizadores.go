@@ -0,0 +1,198 @@
+package rastro
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"modulo/idioma"
+)
+
+// Saida é onde uma lição é executada: recebe o texto comum (io.Writer) e os
+// passos (Destino). Finalizar deve ser chamado quando a lição terminar.
+type Saida interface {
+	io.Writer
+	Destino
+	Finalizar() error
+}
+
+// Formatos aceitos por NovaSaida.
+const (
+	FormatoTexto = "texto"
+	FormatoCores = "cores"
+	FormatoJSON  = "json"
+)
+
+// NovaSaida cria o renderizador do formato pedido escrevendo em w.
+func NovaSaida(formato string, w io.Writer) (Saida, error) {
+	switch formato {
+	case "", FormatoTexto:
+		return NovoTexto(w), nil
+	case FormatoCores:
+		return NovoColorido(w), nil
+	case FormatoJSON:
+		return NovoJSON(w), nil
+	}
+	return nil, fmt.Errorf("formato %q inválido (use %s, %s ou %s)", formato, FormatoTexto, FormatoCores, FormatoJSON)
+}
+
+// recuo alinha a expressão embaixo da descrição do passo.
+const recuo = "         "
+
+// estilo é uma cor ANSI; o renderizador de texto simples ignora os estilos.
+type estilo string
+
+const (
+	semEstilo estilo = ""
+	negrito   estilo = "\033[1m"
+	ciano     estilo = "\033[36m"
+	verde     estilo = "\033[32m"
+	vermelho  estilo = "\033[31m"
+	amarelo   estilo = "\033[33m"
+	cinza     estilo = "\033[90m"
+	restaurar        = "\033[0m"
+)
+
+// formatar devolve as linhas de um passo; pintar aplica (ou não) as cores.
+func formatar(p Passo, pintar func(estilo, string) string) string {
+	numero := func() string {
+		return pintar(negrito, fmt.Sprintf(idioma.T("PASSO %d: "), p.Numero))
+	}
+	switch p.Tipo {
+	case Secao:
+		return "\n" + pintar(negrito, "=== "+p.Texto+" ===") + "\n"
+	case Cenario:
+		return "\n" + pintar(ciano, "--- "+p.Texto+" ---") + "\n"
+	case Avaliacao:
+		return numero() + p.Texto + "\n" + recuo + p.Expressao + " = " + pintar(corDoValor(p.Valor), p.Valor) + "\n"
+	case Pulo:
+		return numero() + pintar(amarelo, p.Texto) + "\n" + recuo + pintar(cinza, fmt.Sprintf(idioma.T("O compilador para aqui e não verifica %s"), p.Expressao)) + "\n"
+	case Conclusao:
+		return numero() + p.Expressao + " = " + pintar(corDoValor(p.Valor), p.Valor) + "\n"
+	case Decisao:
+		return pintar(negrito, idioma.T("RESULTADO: ")+p.Texto) + "\n"
+	case Aviso:
+		return recuo + pintar(amarelo, "⚠ "+p.Texto) + "\n"
+	case Detalhe:
+		var linhas strings.Builder
+		for _, linha := range strings.Split(p.Texto, "\n") {
+			linhas.WriteString(recuo + pintar(cinza, linha) + "\n")
+		}
+		return linhas.String()
+	}
+	return p.Texto + "\n"
+}
+
+func corDoValor(valor string) estilo {
+	switch valor {
+	case "true":
+		return verde
+	case "false":
+		return vermelho
+	}
+	return semEstilo
+}
+
+// Texto escreve os passos como texto simples, no formato das lições.
+type Texto struct {
+	w io.Writer
+}
+
+// NovoTexto cria um renderizador de texto simples.
+func NovoTexto(w io.Writer) *Texto {
+	return &Texto{w: w}
+}
+
+func (t *Texto) Write(b []byte) (int, error) { return t.w.Write(b) }
+
+func (t *Texto) Passo(p Passo) {
+	io.WriteString(t.w, formatar(p, func(_ estilo, s string) string { return s }))
+}
+
+func (t *Texto) Finalizar() error { return nil }
+
+// Colorido escreve os passos com cores ANSI para o terminal: valores true em
+// verde, false em vermelho e expressões puladas e avisos em amarelo.
+type Colorido struct {
+	w io.Writer
+}
+
+// NovoColorido cria um renderizador com cores de terminal.
+func NovoColorido(w io.Writer) *Colorido {
+	return &Colorido{w: w}
+}
+
+func (c *Colorido) Write(b []byte) (int, error) { return c.w.Write(b) }
+
+func (c *Colorido) Passo(p Passo) {
+	io.WriteString(c.w, formatar(p, func(e estilo, s string) string {
+		if e == semEstilo {
+			return s
+		}
+		return string(e) + s + restaurar
+	}))
+}
+
+func (c *Colorido) Finalizar() error { return nil }
+
+// JSON guarda os passos e, em Finalizar, escreve todos como um array JSON.
+// O texto comum escrito pela lição vira passos do tipo Mensagem, um por linha.
+type JSON struct {
+	w       io.Writer
+	passos  []Passo
+	parcial strings.Builder
+}
+
+// NovoJSON cria um renderizador JSON.
+func NovoJSON(w io.Writer) *JSON {
+	return &JSON{w: w}
+}
+
+func (j *JSON) Write(b []byte) (int, error) {
+	j.parcial.Write(b)
+	texto := j.parcial.String()
+	fim := strings.LastIndexByte(texto, '\n')
+	if fim < 0 {
+		return len(b), nil
+	}
+	for _, linha := range strings.Split(texto[:fim], "\n") {
+		j.mensagem(linha)
+	}
+	j.parcial.Reset()
+	j.parcial.WriteString(texto[fim+1:])
+	return len(b), nil
+}
+
+func (j *JSON) mensagem(linha string) {
+	if linha = strings.TrimSpace(linha); linha != "" {
+		j.passos = append(j.passos, Passo{Tipo: Mensagem, Texto: linha})
+	}
+}
+
+func (j *JSON) esvaziar() {
+	j.mensagem(j.parcial.String())
+	j.parcial.Reset()
+}
+
+func (j *JSON) Passo(p Passo) {
+	j.esvaziar()
+	j.passos = append(j.passos, p)
+}
+
+// Passos devolve todos os passos recebidos até agora.
+func (j *JSON) Passos() []Passo {
+	j.esvaziar()
+	return j.passos
+}
+
+func (j *JSON) Finalizar() error {
+	passos := j.Passos()
+	if passos == nil {
+		passos = []Passo{}
+	}
+	codificador := json.NewEncoder(j.w)
+	codificador.SetEscapeHTML(false)
+	codificador.SetIndent("", "  ")
+	return codificador.Encode(passos)
+}
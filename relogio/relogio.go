@@ -0,0 +1,148 @@
+package relogio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"modulo/idioma"
+)
+
+// Relogio é tudo o que as lições usam do tempo. As lições chamam Dormir e
+// Agora deste pacote em vez de time.Sleep e time.Now, para que o curso possa
+// rodar em velocidade real, acelerado ou sem esperar nada.
+type Relogio interface {
+	Agora() time.Time
+	Dormir(d time.Duration)
+}
+
+// Real usa o tempo de verdade.
+type Real struct{}
+
+func (Real) Agora() time.Time       { return time.Now() }
+func (Real) Dormir(d time.Duration) { time.Sleep(d) }
+
+// Acelerado espera Fator vezes menos que o pedido.
+type Acelerado struct {
+	Fator int
+}
+
+func (Acelerado) Agora() time.Time { return time.Now() }
+
+func (a Acelerado) Dormir(d time.Duration) {
+	time.Sleep(d / time.Duration(a.Fator))
+}
+
+// Instantaneo não espera: Dormir volta na hora.
+type Instantaneo struct{}
+
+func (Instantaneo) Agora() time.Time     { return time.Now() }
+func (Instantaneo) Dormir(time.Duration) {}
+
+// Falso é um relógio para testes: Dormir não espera, apenas avança o
+// horário, e cada espera fica registrada em Esperas.
+type Falso struct {
+	mu      sync.Mutex
+	agora   time.Time
+	esperas []time.Duration
+}
+
+// NovoFalso cria um relógio falso parado em inicio.
+func NovoFalso(inicio time.Time) *Falso {
+	return &Falso{agora: inicio}
+}
+
+func (f *Falso) Agora() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.agora
+}
+
+func (f *Falso) Dormir(d time.Duration) {
+	f.Avancar(d)
+	f.mu.Lock()
+	f.esperas = append(f.esperas, d)
+	f.mu.Unlock()
+}
+
+// Avancar move o horário do relógio falso para frente.
+func (f *Falso) Avancar(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.agora = f.agora.Add(d)
+}
+
+// Esperas devolve as durações pedidas a Dormir, na ordem.
+func (f *Falso) Esperas() []time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Duration(nil), f.esperas...)
+}
+
+// FatorRapido é quantas vezes a velocidade "rapida" é mais rápida que a real.
+const FatorRapido = 10
+
+// Velocidade converte o valor de --velocidade em um relógio.
+// Os nomes em inglês também são aceitos.
+func Velocidade(nome string) (Relogio, error) {
+	switch strings.ToLower(nome) {
+	case "", "real":
+		return Real{}, nil
+	case "rapida", "rápida", "fast":
+		return Acelerado{Fator: FatorRapido}, nil
+	case "instantanea", "instantânea", "instant":
+		return Instantaneo{}, nil
+	}
+	return nil, fmt.Errorf(idioma.T("velocidade %q inválida (use real, rapida ou instantanea)"), nome)
+}
+
+type caixa struct{ Relogio }
+
+var atual atomic.Value
+
+func init() {
+	atual.Store(caixa{Real{}})
+}
+
+// Definir troca o relógio usado pelas lições.
+func Definir(r Relogio) {
+	atual.Store(caixa{r})
+}
+
+// Atual devolve o relógio em uso.
+func Atual() Relogio {
+	return atual.Load().(caixa).Relogio
+}
+
+// Agora é o horário do relógio em uso.
+func Agora() time.Time {
+	return Atual().Agora()
+}
+
+// Dormir espera d no relógio em uso.
+func Dormir(d time.Duration) {
+	Atual().Dormir(d)
+}
+
+// DormirOuCancelar espera d em r, mas volta antes, com ctx.Err(), se ctx for
+// cancelado durante a espera. Como Relogio não conhece contextos, r.Dormir
+// roda numa goroutine que termina sozinha quando d passar.
+func DormirOuCancelar(ctx context.Context, r Relogio, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	pronto := make(chan struct{})
+	go func() {
+		r.Dormir(d)
+		close(pronto)
+	}()
+	select {
+	case <-pronto:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,86 @@
+package relogio
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFalso(t *testing.T) {
+	inicio := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := NovoFalso(inicio)
+
+	f.Dormir(time.Second)
+	f.Dormir(2 * time.Second)
+	f.Avancar(time.Minute)
+
+	if obtido := f.Agora(); !obtido.Equal(inicio.Add(time.Minute + 3*time.Second)) {
+		t.Errorf("Agora() = %v", obtido)
+	}
+	if esperas := f.Esperas(); !slices.Equal(esperas, []time.Duration{time.Second, 2 * time.Second}) {
+		t.Errorf("Esperas() = %v", esperas)
+	}
+}
+
+func TestVelocidade(t *testing.T) {
+	casos := map[string]Relogio{
+		"":            Real{},
+		"real":        Real{},
+		"rapida":      Acelerado{Fator: FatorRapido},
+		"fast":        Acelerado{Fator: FatorRapido},
+		"instantanea": Instantaneo{},
+		"Instant":     Instantaneo{},
+	}
+	for nome, esperado := range casos {
+		obtido, err := Velocidade(nome)
+		if err != nil || obtido != esperado {
+			t.Errorf("Velocidade(%q) = %#v, %v; esperado %#v", nome, obtido, err, esperado)
+		}
+	}
+	if _, err := Velocidade("lenta"); err == nil {
+		t.Error("Velocidade(\"lenta\") deveria falhar")
+	}
+}
+
+func TestDefinir(t *testing.T) {
+	defer Definir(Real{})
+
+	f := NovoFalso(time.Time{})
+	Definir(f)
+	inicio := time.Now()
+	Dormir(time.Hour)
+	if time.Since(inicio) > time.Second {
+		t.Error("Dormir esperou de verdade com o relógio falso")
+	}
+	if !Agora().Equal(time.Time{}.Add(time.Hour)) {
+		t.Errorf("Agora() = %v", Agora())
+	}
+}
+
+func TestDormirOuCancelar(t *testing.T) {
+	f := NovoFalso(time.Time{})
+	if err := DormirOuCancelar(context.Background(), f, time.Second); err != nil {
+		t.Errorf("DormirOuCancelar sem cancelar = %v, esperado nil", err)
+	}
+
+	ctx, cancelar := context.WithCancel(context.Background())
+	cancelar()
+	if err := DormirOuCancelar(ctx, f, time.Second); !errors.Is(err, context.Canceled) {
+		t.Errorf("DormirOuCancelar com contexto cancelado = %v, esperado Canceled", err)
+	}
+	if obtido := f.Esperas(); !slices.Equal(obtido, []time.Duration{time.Second}) {
+		t.Errorf("esperas = %v, esperado só a primeira", obtido)
+	}
+
+	ctx, cancelar = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelar()
+	inicio := time.Now()
+	if err := DormirOuCancelar(ctx, Real{}, time.Hour); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DormirOuCancelar(Real, 1h) = %v, esperado DeadlineExceeded", err)
+	}
+	if d := time.Since(inicio); d > time.Second {
+		t.Errorf("DormirOuCancelar levou %v, esperado voltar no cancelamento", d)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"embed"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"modulo/idioma"
+	"modulo/playground"
+	"modulo/progresso"
+)
+
+// Os READMEs e o código das lições vão dentro do binário,
+// então o site funciona mesmo fora do diretório do repositório.
+//
+//go:embed README.md docs/*.md */*.go
+var arquivosDoCurso embed.FS
+
+// servir sobe o playground web: go run . servir [-endereco localhost:8080] [-perfil nome]
+func servir(args []string) {
+	flags := flag.NewFlagSet("servir", flag.ExitOnError)
+	endereco := flags.String("endereco", "localhost:8080", idioma.T("endereço onde o site vai escutar"))
+	perfil := flags.String("perfil", os.Getenv("CURSO_PERFIL"), idioma.T("aluno que terá as lições abertas marcadas como vistas"))
+	flags.Parse(args)
+
+	site := playground.Novo(arquivosDoCurso)
+	if caminho, err := progresso.CaminhoPadrao(); err == nil {
+		if *perfil == "" {
+			*perfil = progresso.PerfilPadrao()
+		}
+		site.RegistrarProgresso(caminho, *perfil)
+	}
+
+	fmt.Fprintf(os.Stdout, idioma.T("Playground do curso em http://%s\n"), *endereco)
+	log.Fatal(http.ListenAndServe(*endereco, site))
+}
@@ -1,63 +1,67 @@
 package slice
 
-import "fmt"
-import "reflect" // para saber o tipo de um slice
-
-func Slice() {
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+	"reflect" // para saber o tipo de um slice
+)
+
+func Slice(w io.Writer) {
 	slice := []int{ 1, 2, 3, 4, 5}
-	fmt.Println(reflect.TypeOf(slice))
-	fmt.Println("Slice:", slice)
+	fmt.Fprintln(w, reflect.TypeOf(slice))
+	fmt.Fprintln(w, "Slice:", slice)
 
 	}
 
-	func Append() {
+	func Append(w io.Writer) {
 		slice := []int{ 1, 2, 3, 4, 5}
 		slice = append(slice, 6)
-		fmt.Println("Append:", slice)
+		fmt.Fprintln(w, "Append:", slice)
 	}
 
-	func AppendMultiplos() {
+	func AppendMultiplos(w io.Writer) {
 		slice := []int{ 1, 2, 3, 4, 5}
 		slice = append(slice, 6, 7, 8, 9, 10)
-		fmt.Println("Append multiplos:", slice)
+		fmt.Fprintln(w, idioma.T("Append multiplos:"), slice)
 	}
 
-	func AtribuiArrayASlice() {
+	func AtribuiArrayASlice(w io.Writer) {
 		array := [5]int{ 1, 2, 3, 4, 5}
 		slice := array[:]
-		fmt.Println("Atribuindo array a slice:", slice)
+		fmt.Fprintln(w, idioma.T("Atribuindo array a slice:"), slice)
 	}
 
-	func AtribuiArrayASlicePeloIndice() {
+	func AtribuiArrayASlicePeloIndice(w io.Writer) {
 		array := [5]int{ 1, 2, 3, 4, 5}
 		slice := array[1:3]
-		fmt.Println("Atribuindo array a slice pelo indice 1 até 3:", slice)
+		fmt.Fprintln(w, idioma.T("Atribuindo array a slice pelo indice 1 até 3:"), slice)
 	}
 
 
-	func RemoverItemPorIndice() {
+	func RemoverItemPorIndice(w io.Writer) {
 		slice := []int{ 1, 2, 3, 4, 5}
 		slice = append(slice[:2], slice[3:]...)
-		fmt.Println("Removendo item por indice:", slice, "Tamanho do slice:", len(slice), "Capacidade do slice:", cap(slice))
+		fmt.Fprintln(w, idioma.T("Removendo item por indice:"), slice, idioma.T("Tamanho do slice:"), len(slice), idioma.T("Capacidade do slice:"), cap(slice))
 	}
 
-	func CriarSliceComMake() {
+	func CriarSliceComMake(w io.Writer) {
 		slice := make([]int, 5)
-		fmt.Println("Criando slice com make:", slice, "Tamanho do slice:", len(slice), "Capacidade do slice:", cap(slice))
+		fmt.Fprintln(w, idioma.T("Criando slice com make:"), slice, idioma.T("Tamanho do slice:"), len(slice), idioma.T("Capacidade do slice:"), cap(slice))
 	}
 
-	func CriarSliceComMakeVazioComCapacidadeInicial() {
+	func CriarSliceComMakeVazioComCapacidadeInicial(w io.Writer) {
 		slice := make([]int, 0, 10)
-		fmt.Println("Criando slice com make vazio com capacidade inicial:", slice, "Tamanho do slice:", len(slice), "Capacidade do slice:", cap(slice))
+		fmt.Fprintln(w, idioma.T("Criando slice com make vazio com capacidade inicial:"), slice, idioma.T("Tamanho do slice:"), len(slice), idioma.T("Capacidade do slice:"), cap(slice))
 	}
 
-	func CriarSliceComMakePrePreenchidoComZeros() {
+	func CriarSliceComMakePrePreenchidoComZeros(w io.Writer) {
 		slice := make([]int, 5)
-		fmt.Println("Criando slice com make preenchido com zeros:", slice, "Tamanho do slice:", len(slice), "Capacidade do slice:", cap(slice))
+		fmt.Fprintln(w, idioma.T("Criando slice com make preenchido com zeros:"), slice, idioma.T("Tamanho do slice:"), len(slice), idioma.T("Capacidade do slice:"), cap(slice))
 	}
-	func CriarSliceComMakeTamanhoECapacidadeDiferentes() {
+	func CriarSliceComMakeTamanhoECapacidadeDiferentes(w io.Writer) {
 		slice := make([]int, 3, 10)
-		fmt.Println("Criando slice com make tamanho e capacidade diferentes:", slice, "Tamanho do slice:", len(slice), "Capacidade do slice:", cap(slice))
+		fmt.Fprintln(w, idioma.T("Criando slice com make tamanho e capacidade diferentes:"), slice, idioma.T("Tamanho do slice:"), len(slice), idioma.T("Capacidade do slice:"), cap(slice))
 	}
 
 
@@ -1,8 +1,12 @@
 package structs
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func Structs() {
+func Structs(w io.Writer) {
 
 	type Endereco struct {
 		Rua    string
@@ -21,8 +25,8 @@ func Structs() {
 	}
 
 	usuario := Usuario{1, "João", "joao@example.com", "123456", Endereco{"Rua das Flores", 123, "São Paulo", "SP", "1234567890"}}
-	fmt.Printf("Usuario: %+v\n", usuario)
+	fmt.Fprintf(w, idioma.T("Usuario: %+v\n"), usuario)
 	
 	usuario2 := Usuario{Nome: "Mike"}
-	fmt.Printf("Usuario2: %+v\n", usuario2)
+	fmt.Fprintf(w, idioma.T("Usuario2: %+v\n"), usuario2)
 }
@@ -1,19 +1,23 @@
 package switchs
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func Switch() {
+func Switch(w io.Writer) {
 	var numero int = 12
 
 	switch numero {
 	case 1:
-		fmt.Println("O numero é 1")
+		fmt.Fprintln(w, idioma.T("O numero é 1"))
 	case 2:
-		fmt.Println("O numero é 2")
+		fmt.Fprintln(w, idioma.T("O numero é 2"))
 	case 3:
-		fmt.Println("O numero é 3")
+		fmt.Fprintln(w, idioma.T("O numero é 3"))
 	default:
-		fmt.Println("O numero é diferente de 1, 2 e 3")
+		fmt.Fprintln(w, idioma.T("O numero é diferente de 1, 2 e 3"))
 	}
 }
 
@@ -22,21 +26,21 @@ func SwitchComRetorno() string {
 
 	switch numero {
 	case 1:
-		return "Domingo"
+		return idioma.T("Domingo")
 	case 2:
-		return "Segunda-feira"
+		return idioma.T("Segunda-feira")
 	case 3:
-		return "Terça-feira"
+		return idioma.T("Terça-feira")
 	case 4:
-		return "Quarta-feira"
+		return idioma.T("Quarta-feira")
 	case 5:
-		return "Quinta-feira"
+		return idioma.T("Quinta-feira")
 	case 6:
-		return "Sexta-feira"
+		return idioma.T("Sexta-feira")
 	case 7:
-		return "Sábado"
+		return idioma.T("Sábado")
 	default:
-		return "Dia inválido"
+		return idioma.T("Dia inválido")
 	}
 }
 
@@ -45,21 +49,21 @@ func SwitcComAtribuicaoDeVariavel(numero int) string {
 
 	switch {
 	case numero == 1:
-		diaDaSemana = "Domingo"
+		diaDaSemana = idioma.T("Domingo")
 	case numero == 2:
-		diaDaSemana = "Segunda-feira"
+		diaDaSemana = idioma.T("Segunda-feira")
 	case numero == 3:
-		diaDaSemana = "Terça-feira"
+		diaDaSemana = idioma.T("Terça-feira")
 	case numero == 4:
-		diaDaSemana = "Quarta-feira"
+		diaDaSemana = idioma.T("Quarta-feira")
 	case numero == 5:
-		diaDaSemana = "Quinta-feira"
+		diaDaSemana = idioma.T("Quinta-feira")
 	case numero == 6:
-		diaDaSemana = "Sexta-feira"
+		diaDaSemana = idioma.T("Sexta-feira")
 	case numero == 7:
-		diaDaSemana = "Sábado"
+		diaDaSemana = idioma.T("Sábado")
 	default:
-		diaDaSemana = "Dia inválido"
+		diaDaSemana = idioma.T("Dia inválido")
 	}
 	return diaDaSemana
 }
\ No newline at end of file
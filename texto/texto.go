@@ -0,0 +1,231 @@
+// Package texto mostra como uma string do Go é guardada: bytes em UTF-8, que
+// o for range lê como runas, que por sua vez se juntam em caracteres visíveis.
+package texto
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+	"unicode/utf8"
+
+	"modulo/idioma"
+)
+
+// Runa é uma runa do texto e o lugar em que ela está.
+type Runa struct {
+	Indice    int    // posição contando runas (0, 1, 2...)
+	Byte      int    // posição do primeiro byte, o índice que o for range devolve
+	Valor     rune   // utf8.RuneError quando os bytes não são UTF-8 válido
+	Bytes     []byte // os bytes que formam a runa
+	Valida    bool
+	Categoria string // categoria Unicode, como "Lu" ou "Mn"
+}
+
+// Grupo é o que aparece como um caractere só na tela: uma runa base seguida
+// das marcas que se combinam com ela (acentos, modificadores de emoji, runas
+// ligadas por zero width joiner). Não é a regra completa de grafemas do
+// Unicode, mas cobre os casos comuns.
+type Grupo struct {
+	Texto string
+	Runas []Runa
+}
+
+// Analise é o resultado de Explorar.
+type Analise struct {
+	Texto  string
+	Runas  []Runa
+	Grupos []Grupo
+}
+
+// Explorar separa s em runas, como o for range faz, e as junta em grupos.
+func Explorar(s string) *Analise {
+	a := &Analise{Texto: s}
+	for i := 0; i < len(s); {
+		r, tamanho := utf8.DecodeRuneInString(s[i:])
+		valida := r != utf8.RuneError || tamanho > 1
+		runa := Runa{
+			Indice:    len(a.Runas),
+			Byte:      i,
+			Valor:     r,
+			Bytes:     []byte(s[i : i+tamanho]),
+			Valida:    valida,
+			Categoria: categoria(r, valida),
+		}
+		a.Runas = append(a.Runas, runa)
+		i += tamanho
+	}
+	a.agrupar()
+	return a
+}
+
+const (
+	zeroWidthJoiner = '\u200d'
+	indicadorA      = '\U0001F1E6' // as bandeiras são pares de indicadores regionais
+	indicadorZ      = '\U0001F1FF'
+)
+
+func (a *Analise) agrupar() {
+	for i, runa := range a.Runas {
+		if i > 0 && junta(a.Runas[i-1], runa, len(a.Grupos[len(a.Grupos)-1].Runas)) {
+			g := &a.Grupos[len(a.Grupos)-1]
+			g.Runas = append(g.Runas, runa)
+			g.Texto += string(runa.Bytes)
+			continue
+		}
+		a.Grupos = append(a.Grupos, Grupo{Texto: string(runa.Bytes), Runas: []Runa{runa}})
+	}
+}
+
+// junta diz se a runa continua o grupo que termina em anterior.
+func junta(anterior, runa Runa, tamanhoDoGrupo int) bool {
+	if !runa.Valida || !anterior.Valida {
+		return false
+	}
+	switch {
+	case unicode.Is(unicode.M, runa.Valor), runa.Valor == zeroWidthJoiner, modificadorDeEmoji(runa.Valor):
+		return true
+	case anterior.Valor == zeroWidthJoiner:
+		return true
+	case indicador(anterior.Valor) && indicador(runa.Valor):
+		return tamanhoDoGrupo == 1
+	}
+	return false
+}
+
+func modificadorDeEmoji(r rune) bool {
+	return r >= '\U0001F3FB' && r <= '\U0001F3FF'
+}
+
+func indicador(r rune) bool {
+	return r >= indicadorA && r <= indicadorZ
+}
+
+// categorias na ordem em que são testadas; a primeira que contém a runa vence.
+var categorias = []struct {
+	codigo string
+	tabela *unicode.RangeTable
+}{
+	{"Lu", unicode.Lu}, {"Ll", unicode.Ll}, {"Lt", unicode.Lt}, {"Lm", unicode.Lm}, {"Lo", unicode.Lo},
+	{"Mn", unicode.Mn}, {"Mc", unicode.Mc}, {"Me", unicode.Me},
+	{"Nd", unicode.Nd}, {"Nl", unicode.Nl}, {"No", unicode.No},
+	{"Pc", unicode.Pc}, {"Pd", unicode.Pd}, {"Ps", unicode.Ps}, {"Pe", unicode.Pe},
+	{"Pi", unicode.Pi}, {"Pf", unicode.Pf}, {"Po", unicode.Po},
+	{"Sm", unicode.Sm}, {"Sc", unicode.Sc}, {"Sk", unicode.Sk}, {"So", unicode.So},
+	{"Zs", unicode.Zs}, {"Zl", unicode.Zl}, {"Zp", unicode.Zp},
+	{"Cc", unicode.Cc}, {"Cf", unicode.Cf}, {"Co", unicode.Co},
+}
+
+func categoria(r rune, valida bool) string {
+	if !valida {
+		return ""
+	}
+	for _, c := range categorias {
+		if unicode.Is(c.tabela, r) {
+			return c.codigo
+		}
+	}
+	return "Cn" // sem categoria: código ainda não atribuído
+}
+
+// NomeDaCategoria explica um código de categoria como "Lu".
+func NomeDaCategoria(codigo string) string {
+	switch {
+	case codigo == "":
+		return idioma.T("UTF-8 inválido")
+	case codigo == "Lu":
+		return idioma.T("letra maiúscula")
+	case codigo == "Ll":
+		return idioma.T("letra minúscula")
+	case codigo[0] == 'L':
+		return idioma.T("letra")
+	case codigo[0] == 'M':
+		return idioma.T("marca combinante")
+	case codigo == "Nd":
+		return idioma.T("dígito")
+	case codigo[0] == 'N':
+		return idioma.T("número")
+	case codigo[0] == 'P':
+		return idioma.T("pontuação")
+	case codigo[0] == 'S':
+		return idioma.T("símbolo")
+	case codigo[0] == 'Z':
+		return idioma.T("espaço")
+	case codigo == "Cc":
+		return idioma.T("controle")
+	case codigo == "Cf":
+		return idioma.T("formatação invisível")
+	}
+	return idioma.T("outro")
+}
+
+// Invalidas conta as runas que não são UTF-8 válido.
+func (a *Analise) Invalidas() int {
+	n := 0
+	for _, r := range a.Runas {
+		if !r.Valida {
+			n++
+		}
+	}
+	return n
+}
+
+// Escrever mostra em w os totais, uma tabela com cada runa e os grupos.
+func (a *Analise) Escrever(w io.Writer) error {
+	fmt.Fprintf(w, "%s\n", strconv.Quote(a.Texto))
+	fmt.Fprintf(w, idioma.T("len = %d bytes, utf8.RuneCountInString = %d runas, %d caracteres visíveis\n"), len(a.Texto), len(a.Runas), len(a.Grupos))
+	if n := a.Invalidas(); n > 0 {
+		fmt.Fprintf(w, idioma.T("⚠ UTF-8 inválido em %d byte(s): o for range devolve %s (U+FFFD) para cada um\n"), n, "�")
+	}
+	if len(a.Runas) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, idioma.T("RUNA\tBYTE\tCARACTERE\tCÓDIGO\tUTF-8\tCATEGORIA"))
+	for _, r := range a.Runas {
+		codigo := fmt.Sprintf("%U", r.Valor)
+		if !r.Valida {
+			codigo = "-"
+		}
+		categoria := strings.TrimSpace(r.Categoria + " " + NomeDaCategoria(r.Categoria))
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t% X\t%s\n", r.Indice, r.Byte, mostrar(r), codigo, r.Bytes, categoria)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, idioma.T("CARACTERES VISÍVEIS:"))
+	for i, g := range a.Grupos {
+		if len(g.Runas) == 1 {
+			fmt.Fprintf(w, "%d: %s\n", i, mostrar(g.Runas[0]))
+			continue
+		}
+		partes := make([]string, len(g.Runas))
+		for j, r := range g.Runas {
+			partes[j] = mostrar(r)
+		}
+		fmt.Fprintf(w, idioma.T("%d: %s = %s (runas %d a %d)\n"), i, g.Texto, strings.Join(partes, " + "), g.Runas[0].Indice, g.Runas[len(g.Runas)-1].Indice)
+	}
+	return nil
+}
+
+// mostrar devolve uma forma visível da runa: as invisíveis viram escapes e as
+// marcas combinantes aparecem sobre um círculo pontilhado, como nas tabelas Unicode.
+func mostrar(r Runa) string {
+	switch {
+	case !r.Valida:
+		return fmt.Sprintf(`\x%02x`, r.Bytes[0])
+	case unicode.Is(unicode.M, r.Valor):
+		return "◌" + string(r.Valor)
+	case unicode.IsControl(r.Valor), unicode.Is(unicode.Cf, r.Valor), unicode.Is(unicode.Z, r.Valor) && r.Valor != ' ':
+		return strings.Trim(strconv.QuoteRuneToASCII(r.Valor), "'")
+	case r.Valor == ' ':
+		return "' '"
+	}
+	return string(r.Valor)
+}
@@ -0,0 +1,108 @@
+package texto
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestExplorarPosicoes(t *testing.T) {
+	a := Explorar("aé€😀")
+	var bytesIniciais, tamanhos []int
+	for i, r := range a.Runas {
+		if r.Indice != i {
+			t.Errorf("runa %d com Indice %d", i, r.Indice)
+		}
+		bytesIniciais = append(bytesIniciais, r.Byte)
+		tamanhos = append(tamanhos, len(r.Bytes))
+	}
+	if !slices.Equal(bytesIniciais, []int{0, 1, 3, 6}) || !slices.Equal(tamanhos, []int{1, 2, 3, 4}) {
+		t.Errorf("bytes = %v, tamanhos = %v", bytesIniciais, tamanhos)
+	}
+
+	// As posições são as mesmas que o for range devolve.
+	var doRange []int
+	for i := range "aé€😀" {
+		doRange = append(doRange, i)
+	}
+	if !slices.Equal(bytesIniciais, doRange) {
+		t.Errorf("posições %v diferentes do for range %v", bytesIniciais, doRange)
+	}
+	if !bytes.Equal(a.Runas[2].Bytes, []byte{0xE2, 0x82, 0xAC}) {
+		t.Errorf("UTF-8 de € = % X", a.Runas[2].Bytes)
+	}
+}
+
+func TestCategorias(t *testing.T) {
+	casos := map[string]string{
+		"A": "Lu", "a": "Ll", "中": "Lo", "\u0301": "Mn", "7": "Nd", "½": "No",
+		"!": "Po", "-": "Pd", "+": "Sm", "$": "Sc", "😀": "So", " ": "Zs",
+		"\n": "Cc", "\u200d": "Cf", "\U000E0080": "Cn",
+	}
+	for s, esperado := range casos {
+		if c := Explorar(s).Runas[0].Categoria; c != esperado {
+			t.Errorf("categoria de %q = %s, esperado %s", s, c, esperado)
+		}
+	}
+}
+
+func TestInvalidos(t *testing.T) {
+	a := Explorar("a\xffb\xe2\x82")
+	if len(a.Runas) != 5 || a.Invalidas() != 3 {
+		t.Fatalf("runas = %d, inválidas = %d; esperado 5 e 3", len(a.Runas), a.Invalidas())
+	}
+	if r := a.Runas[1]; r.Valida || r.Byte != 1 || r.Categoria != "" {
+		t.Errorf("runa inválida = %+v", r)
+	}
+	// "\xef\xbf\xbd" é o próprio U+FFFD, escrito corretamente.
+	if Explorar("\uFFFD").Invalidas() != 0 {
+		t.Error("U+FFFD válido foi marcado como inválido")
+	}
+}
+
+func TestGrupos(t *testing.T) {
+	casos := []struct {
+		texto  string
+		grupos []string
+	}{
+		{"cafe\u0301", []string{"c", "a", "f", "e\u0301"}},
+		{"caf\u00e9", []string{"c", "a", "f", "\u00e9"}},
+		{"a\u0323\u0307", []string{"a\u0323\u0307"}},
+		{"👍🏽!", []string{"👍🏽", "!"}},
+		{"👨\u200d👩\u200d👧", []string{"👨\u200d👩\u200d👧"}},
+		{"🇧🇷🇵🇹", []string{"🇧🇷", "🇵🇹"}},
+		{"🇧🇷🇵", []string{"🇧🇷", "🇵"}},
+		{"\u0301a", []string{"\u0301", "a"}},
+		{"a\xff\u0301", []string{"a", "\xff", "\u0301"}},
+	}
+	for _, c := range casos {
+		var grupos []string
+		for _, g := range Explorar(c.texto).Grupos {
+			grupos = append(grupos, g.Texto)
+		}
+		if !slices.Equal(grupos, c.grupos) {
+			t.Errorf("grupos de %q = %q, esperado %q", c.texto, grupos, c.grupos)
+		}
+	}
+}
+
+func TestEscrever(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Explorar("e\u0301\xff").Escrever(&buf); err != nil {
+		t.Fatal(err)
+	}
+	saida := buf.String()
+	for _, trecho := range []string{
+		"\"e\u0301\\xff\"",
+		"len = 4 bytes, utf8.RuneCountInString = 3 runas, 2 caracteres visíveis",
+		"UTF-8 inválido em 1 byte(s)",
+		"1     1     ◌\u0301         U+0301  CC 81  Mn marca combinante",
+		`2     3     \xff       -       FF     UTF-8 inválido`,
+		"0: e\u0301 = e + ◌\u0301 (runas 0 a 1)",
+	} {
+		if !strings.Contains(saida, trecho) {
+			t.Errorf("saída não contém %q:\n%s", trecho, saida)
+		}
+	}
+}
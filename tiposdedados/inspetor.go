@@ -0,0 +1,23 @@
+package tiposdedados
+
+import (
+	"fmt"
+	"io"
+
+	"modulo/calculadora"
+	"modulo/idioma"
+	"modulo/rastro"
+)
+
+// InspetorDeTipos mostra quanto cada tipo numérico ocupa e que valores guarda,
+// e depois o que acontece ao converter alguns literais para esses tipos.
+func InspetorDeTipos(w io.Writer) {
+	fmt.Fprintln(w)
+	calculadora.EscreverDescricoes(w)
+
+	r := rastro.De(w)
+	for _, literal := range []string{"300", "-1", "3.99", "1e10"} {
+		r.Secao(fmt.Sprintf(idioma.T("CONVERTENDO %s"), literal))
+		calculadora.Converter(literal, r, "int8", "uint8", "int64", "float32")
+	}
+}
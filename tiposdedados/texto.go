@@ -0,0 +1,25 @@
+package tiposdedados
+
+import (
+	"fmt"
+	"io"
+
+	"modulo/idioma"
+	"modulo/texto"
+)
+
+// ExploradorDeTexto mostra por dentro strings em que bytes, runas e
+// caracteres visíveis não são a mesma coisa.
+func ExploradorDeTexto(w io.Writer) {
+	exemplos := []struct{ titulo, texto string }{
+		{idioma.T("SÓ ASCII: 1 BYTE POR RUNA"), "Golang"},
+		{idioma.T("ACENTO PRONTO: 1 RUNA DE 2 BYTES"), "caf\u00e9"},
+		{idioma.T("ACENTO COMBINANTE: 2 RUNAS, 1 CARACTERE"), "cafe\u0301"},
+		{idioma.T("EMOJI COM TOM DE PELE E BANDEIRA"), "👍🏽🇧🇷"},
+		{idioma.T("BYTE INVÁLIDO NO MEIO DO TEXTO"), "Go\xfflang"},
+	}
+	for _, e := range exemplos {
+		fmt.Fprintf(w, "\n=== %s ===\n", e.titulo)
+		texto.Explorar(e.texto).Escrever(w)
+	}
+}
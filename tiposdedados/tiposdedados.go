@@ -3,23 +3,29 @@ package tiposdedados
 import (
 	"errors"
 	"fmt"
+	"modulo/idioma"
 )
 
 func Int() string {
-	int8,
-		int16,
-		int32,
-		int64 := 170, 3, 4, 5
+	var i8 int8 = 127
+	var i16 int16 = 3
+	var i32 int32 = 4
+	var i64 int64 = 5
 
-	return fmt.Sprintf("%d %d %d %d", int8, int16, int32, int64)
+	return fmt.Sprintf("%d %d %d %d", i8, i16, i32, i64)
 }
 
+// Uint mostra que um inteiro sem sinal não guarda negativos: subtrair 1 de
+// zero dá a volta para o maior valor do tipo.
 func Uint() string {
-	uint8,
-		uint16,
-		uint32,
-		uint64 := -12, -1, -2, -3
-	return fmt.Sprintf("%d %d %d %d", uint8, uint16, uint32, uint64)
+	var u8 uint8 = 12
+	var u16 uint16 = 0
+	var u32 uint32 = 0
+	var u64 uint64 = 0
+	u16--
+	u32--
+	u64--
+	return fmt.Sprintf("%d %d %d %d", u8, u16, u32, u64)
 }
 
 func Float() string {
@@ -42,6 +48,6 @@ func Bool() string {
 }
 
 func Erro() string {
-	erro := errors.New("erro de teste")
+	erro := errors.New(idioma.T("erro de teste"))
 	return fmt.Sprintf("%v", erro)
 }
@@ -1,29 +1,33 @@
 package variaveis
 
-import "fmt"
+import (
+	"fmt"
+	"io"
+	"modulo/idioma"
+)
 
-func VariavelExplicita() {
+func VariavelExplicita(w io.Writer) {
 
 	// declarando uma variavel explicita
 	var nome string = "Mike"
-	fmt.Println(nome)
+	fmt.Fprintln(w, nome)
 
 	// declarando mais de uma variavel ao mesmo tempo
 	var (
 		nome1      string = "Mike"
 		sobrenome1 string = "marciano"
 	)
-	fmt.Printf("Nome: %s, Sobrenome: %s\n", nome1, sobrenome1)
+	fmt.Fprintf(w, idioma.T("Nome: %s, Sobrenome: %s\n"), nome1, sobrenome1)
 }
 
-func VariavelImplicita() {
+func VariavelImplicita(w io.Writer) {
 
 	// declarando mais de uma variavel ao mesmo tempo
 	nome2, sobrenome2 := "Mike", "Marciano"
-	fmt.Println(nome2, sobrenome2)
+	fmt.Fprintln(w, nome2, sobrenome2)
 
 	// declarando uma variavel
 	sobrenome := "marciano"
-	fmt.Printf("O sobrenome é %s", sobrenome)
+	fmt.Fprintf(w, idioma.T("O sobrenome é %s"), sobrenome)
 
 }
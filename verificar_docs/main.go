@@ -0,0 +1,61 @@
+// verificar_docs compila e executa os trechos ```go dos arquivos markdown
+// e compara com o bloco ```output que vem logo depois, quando existir.
+//
+//	go run ./verificar_docs            # verifica docs/*.md
+//	go run ./verificar_docs -v docs/README_SLICE.md
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"modulo/documentacao"
+)
+
+func main() {
+	detalhado := flag.Bool("v", false, "mostra também os trechos que passaram ou foram ignorados")
+	flag.Parse()
+
+	arquivos := flag.Args()
+	if len(arquivos) == 0 {
+		var err error
+		arquivos, err = filepath.Glob(filepath.Join("docs", "*.md"))
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var trechos []documentacao.Trecho
+	for _, arquivo := range arquivos {
+		conteudo, err := os.ReadFile(arquivo)
+		if err != nil {
+			log.Fatal(err)
+		}
+		trechos = append(trechos, documentacao.Extrair(arquivo, conteudo)...)
+	}
+
+	resultados, err := documentacao.Verificar(trechos)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	contagem := map[documentacao.Situacao]int{}
+	falhas := 0
+	for _, resultado := range resultados {
+		contagem[resultado.Situacao]++
+		if resultado.Falhou() {
+			falhas++
+		}
+		if resultado.Falhou() || *detalhado {
+			fmt.Println(resultado)
+		}
+	}
+	fmt.Printf("\n%d trechos: %d ok, %d ignorados, %d com falha\n",
+		len(resultados), contagem[documentacao.Passou], contagem[documentacao.Ignorado], falhas)
+	if falhas > 0 {
+		os.Exit(1)
+	}
+}